@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_check_access_not_granted", name="Check Access Not Granted")
+func dataSourceCheckAccessNotGranted() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCheckAccessNotGrantedRead,
+
+		Schema: map[string]*schema.Schema{
+			"access": {
+				Type:     schema.TypeList,
+				Required: true,
+				MinItems: 1,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrActions: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrResources: {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 100,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			names.AttrMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"passed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"policy_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessCheckPolicyType](),
+			},
+			"reasons": reasonSummariesSchema(),
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCheckAccessNotGrantedRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policy := d.Get("policy_document").(string)
+	input := accessanalyzer.CheckAccessNotGrantedInput{
+		Access:         expandAccesses(d.Get("access").([]any)),
+		PolicyDocument: aws.String(policy),
+		PolicyType:     types.AccessCheckPolicyType(d.Get("policy_type").(string)),
+	}
+
+	output, err := conn.CheckAccessNotGranted(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer access not granted: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policy)))
+	d.Set(names.AttrMessage, output.Message)
+	d.Set("passed", output.Result == types.CheckAccessNotGrantedResultPass)
+	if err := d.Set("reasons", flattenReasonSummaries(output.Reasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reasons: %s", err)
+	}
+	d.Set("result", output.Result)
+
+	return diags
+}
+
+func expandAccesses(tfList []any) []types.Access {
+	var apiObjects []types.Access
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Access{}
+
+		if v, ok := tfMap[names.AttrActions].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Actions = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap[names.AttrResources].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.Resources = flex.ExpandStringValueSet(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
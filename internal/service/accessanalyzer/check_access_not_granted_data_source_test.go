@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckAccessNotGrantedDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_access_not_granted.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckAccessNotGrantedDataSourceConfig_basic("s3:DeleteBucket"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "passed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(types.CheckAccessNotGrantedResultPass)),
+				),
+			},
+			{
+				Config: testAccCheckAccessNotGrantedDataSourceConfig_basic("s3:GetObject"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "passed", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reasons.0.description"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(types.CheckAccessNotGrantedResultFail)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckAccessNotGrantedDataSourceConfig_basic(action string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject", "s3:ListBucket"]
+    resources = ["*"]
+  }
+}
+
+data "aws_accessanalyzer_check_access_not_granted" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+
+  access {
+    actions = [%[1]q]
+  }
+}
+`, action)
+}
@@ -0,0 +1,67 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckNoNewAccessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_no_new_access.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNoNewAccessDataSourceConfig_basic(`["s3:GetObject"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "passed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, "reasons.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(types.CheckNoNewAccessResultPass)),
+				),
+			},
+			{
+				Config: testAccCheckNoNewAccessDataSourceConfig_basic(`["s3:GetObject", "s3:PutObject"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "passed", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reasons.0.description"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(types.CheckNoNewAccessResultFail)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNoNewAccessDataSourceConfig_basic(newActions string) string {
+	return `
+data "aws_iam_policy_document" "existing" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::example-bucket/*"]
+  }
+}
+
+data "aws_iam_policy_document" "new" {
+  statement {
+    actions   = ` + newActions + `
+    resources = ["arn:aws:s3:::example-bucket/*"]
+  }
+}
+
+data "aws_accessanalyzer_check_no_new_access" "test" {
+  existing_policy_document = data.aws_iam_policy_document.existing.json
+  new_policy_document      = data.aws_iam_policy_document.new.json
+  policy_type              = "IDENTITY_POLICY"
+}
+`
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_check_no_public_access", name="Check No Public Access")
+func dataSourceCheckNoPublicAccess() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceCheckNoPublicAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrMessage: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"passed": {
+				Type:     schema.TypeBool,
+				Computed: true,
+			},
+			"policy_document": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsJSON,
+			},
+			"reasons": reasonSummariesSchema(),
+			names.AttrResourceType: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[types.AccessCheckResourceType](),
+			},
+			"result": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceCheckNoPublicAccessRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	policy := d.Get("policy_document").(string)
+	input := accessanalyzer.CheckNoPublicAccessInput{
+		PolicyDocument: aws.String(policy),
+		ResourceType:   types.AccessCheckResourceType(d.Get(names.AttrResourceType).(string)),
+	}
+
+	output, err := conn.CheckNoPublicAccess(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "checking IAM Access Analyzer no public access: %s", err)
+	}
+
+	d.SetId(strconv.Itoa(create.StringHashcode(policy)))
+	d.Set(names.AttrMessage, output.Message)
+	d.Set("passed", output.Result == types.CheckNoPublicAccessResultPass)
+	if err := d.Set("reasons", flattenReasonSummaries(output.Reasons)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting reasons: %s", err)
+	}
+	d.Set("result", output.Result)
+
+	return diags
+}
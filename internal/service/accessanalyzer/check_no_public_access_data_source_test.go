@@ -0,0 +1,65 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerCheckNoPublicAccessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_check_no_public_access.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckNoPublicAccessDataSourceConfig_basic("AWS", "arn:aws:iam::123456789012:root"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "passed", acctest.CtTrue),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrResourceType, string(types.AccessCheckResourceTypeS3Bucket)),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(types.CheckNoPublicAccessResultPass)),
+				),
+			},
+			{
+				Config: testAccCheckNoPublicAccessDataSourceConfig_basic("*", "*"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrMessage),
+					resource.TestCheckResourceAttr(dataSourceName, "passed", acctest.CtFalse),
+					resource.TestCheckResourceAttrSet(dataSourceName, "reasons.0.description"),
+					resource.TestCheckResourceAttr(dataSourceName, "result", string(types.CheckNoPublicAccessResultFail)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckNoPublicAccessDataSourceConfig_basic(principalType, principalIdentifier string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::example-bucket/*"]
+
+    principals {
+      type        = %[1]q
+      identifiers = [%[2]q]
+    }
+  }
+}
+
+data "aws_accessanalyzer_check_no_public_access" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  resource_type   = "AWS::S3::Bucket"
+}
+`, principalType, principalIdentifier)
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccAccessAnalyzerPolicyValidationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_accessanalyzer_policy_validation.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.AccessAnalyzerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyValidationDataSourceConfig_basic,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "findings.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "has_errors", acctest.CtFalse),
+					resource.TestCheckResourceAttr(dataSourceName, "has_security_warnings", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccPolicyValidationDataSourceConfig_passRole,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.finding_details"),
+					resource.TestCheckResourceAttr(dataSourceName, "findings.0.finding_type", "SECURITY_WARNING"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.issue_code"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.learn_more_link"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "findings.0.locations.0.path"),
+					resource.TestCheckResourceAttr(dataSourceName, "has_security_warnings", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+const testAccPolicyValidationDataSourceConfig_basic = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["arn:aws:s3:::example-bucket/*"]
+  }
+}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+}
+`
+
+const testAccPolicyValidationDataSourceConfig_passRole = `
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["iam:PassRole"]
+    resources = ["*"]
+  }
+}
+
+data "aws_accessanalyzer_policy_validation" "test" {
+  policy_document = data.aws_iam_policy_document.test.json
+  policy_type     = "IDENTITY_POLICY"
+}
+`
@@ -27,7 +27,38 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
-	return []*inttypes.ServicePackageSDKDataSource{}
+	return []*inttypes.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceCheckAccessNotGranted,
+			TypeName: "aws_accessanalyzer_check_access_not_granted",
+			Name:     "Check Access Not Granted",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  dataSourceCheckNoNewAccess,
+			TypeName: "aws_accessanalyzer_check_no_new_access",
+			Name:     "Check No New Access",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  dataSourceCheckNoPublicAccess,
+			TypeName: "aws_accessanalyzer_check_no_public_access",
+			Name:     "Check No Public Access",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  dataSourcePolicyValidation,
+			TypeName: "aws_accessanalyzer_policy_validation",
+			Name:     "Policy Validation",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  dataSourceUnusedAccessFindings,
+			TypeName: "aws_accessanalyzer_unused_access_findings",
+			Name:     "Unused Access Findings",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
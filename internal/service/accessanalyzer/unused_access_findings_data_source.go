@@ -0,0 +1,341 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package accessanalyzer
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer"
+	"github.com/aws/aws-sdk-go-v2/service/accessanalyzer/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_accessanalyzer_unused_access_findings", name="Unused Access Findings")
+func dataSourceUnusedAccessFindings() *schema.Resource {
+	lastAccessedSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeString,
+			Computed: true,
+		}
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceUnusedAccessFindingsRead,
+
+		Schema: map[string]*schema.Schema{
+			"analyzer_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"findings": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"analyzed_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrCreatedAt: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"finding_type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_owner_account": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrResourceType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"unused_iam_role_details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"last_accessed": lastAccessedSchema(),
+								},
+							},
+						},
+						"unused_iam_user_access_key_details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"access_key_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed": lastAccessedSchema(),
+								},
+							},
+						},
+						"unused_iam_user_password_details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"last_accessed": lastAccessedSchema(),
+								},
+							},
+						},
+						"unused_permission_details": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"actions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrAction: {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"last_accessed": lastAccessedSchema(),
+											},
+										},
+									},
+									"last_accessed": lastAccessedSchema(),
+									"service_namespace": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+						"updated_at": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrResourceARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          types.FindingStatusActive,
+				ValidateDiagFunc: enum.Validate[types.FindingStatus](),
+			},
+		},
+	}
+}
+
+func dataSourceUnusedAccessFindingsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).AccessAnalyzerClient(ctx)
+
+	analyzerARN := d.Get("analyzer_arn").(string)
+	input := accessanalyzer.ListFindingsV2Input{
+		AnalyzerArn: aws.String(analyzerARN),
+		Filter: map[string]types.Criterion{
+			// External and internal access analyzers return other finding types.
+			"findingType": {
+				Eq: enum.Slice(
+					types.FindingTypeUnusedIamRole,
+					types.FindingTypeUnusedIamUserAccessKey,
+					types.FindingTypeUnusedIamUserPassword,
+					types.FindingTypeUnusedPermission,
+				),
+			},
+			names.AttrStatus: {
+				Eq: []string{d.Get(names.AttrStatus).(string)},
+			},
+		},
+	}
+
+	if v, ok := d.GetOk(names.AttrResourceARN); ok {
+		input.Filter["resource"] = types.Criterion{
+			Eq: []string{v.(string)},
+		}
+	}
+
+	findings, err := findUnusedAccessFindings(ctx, conn, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM Access Analyzer Analyzer (%s) unused access findings: %s", analyzerARN, err)
+	}
+
+	d.SetId(analyzerARN)
+	if err := d.Set("findings", flattenUnusedAccessFindings(findings)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting findings: %s", err)
+	}
+
+	return diags
+}
+
+// findUnusedAccessFindings lists the analyzer's findings and gets each one, as only
+// GetFindingV2 returns the unused services and actions.
+func findUnusedAccessFindings(ctx context.Context, conn *accessanalyzer.Client, input *accessanalyzer.ListFindingsV2Input) ([]*accessanalyzer.GetFindingV2Output, error) {
+	var ids []string
+
+	pages := accessanalyzer.NewListFindingsV2Paginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Findings {
+			ids = append(ids, aws.ToString(v.Id))
+		}
+	}
+
+	output := make([]*accessanalyzer.GetFindingV2Output, 0, len(ids))
+
+	for _, id := range ids {
+		finding, err := findFindingV2ByTwoPartKey(ctx, conn, aws.ToString(input.AnalyzerArn), id)
+
+		// Findings can be removed between listing and getting.
+		if errs.IsA[*types.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, finding)
+	}
+
+	return output, nil
+}
+
+func findFindingV2ByTwoPartKey(ctx context.Context, conn *accessanalyzer.Client, analyzerARN, id string) (*accessanalyzer.GetFindingV2Output, error) {
+	input := accessanalyzer.GetFindingV2Input{
+		AnalyzerArn: aws.String(analyzerARN),
+		Id:          aws.String(id),
+	}
+	var output *accessanalyzer.GetFindingV2Output
+
+	pages := accessanalyzer.NewGetFindingV2Paginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.FindingDetails = append(output.FindingDetails, page.FindingDetails...)
+		}
+	}
+
+	return output, nil
+}
+
+func flattenUnusedAccessFindings(apiObjects []*accessanalyzer.GetFindingV2Output) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"analyzed_at":            aws.ToTime(apiObject.AnalyzedAt).Format(time.RFC3339),
+			names.AttrCreatedAt:      aws.ToTime(apiObject.CreatedAt).Format(time.RFC3339),
+			"finding_type":           apiObject.FindingType,
+			names.AttrID:             aws.ToString(apiObject.Id),
+			names.AttrResourceARN:    aws.ToString(apiObject.Resource),
+			"resource_owner_account": aws.ToString(apiObject.ResourceOwnerAccount),
+			names.AttrResourceType:   apiObject.ResourceType,
+			names.AttrStatus:         apiObject.Status,
+			"updated_at":             aws.ToTime(apiObject.UpdatedAt).Format(time.RFC3339),
+		}
+
+		var unusedIAMRoleDetails, unusedIAMUserAccessKeyDetails, unusedIAMUserPasswordDetails, unusedPermissionDetails []any
+
+		for _, v := range apiObject.FindingDetails {
+			switch v := v.(type) {
+			case *types.FindingDetailsMemberUnusedIamRoleDetails:
+				details := map[string]any{}
+				if v := v.Value.LastAccessed; v != nil {
+					details["last_accessed"] = aws.ToTime(v).Format(time.RFC3339)
+				}
+				unusedIAMRoleDetails = append(unusedIAMRoleDetails, details)
+			case *types.FindingDetailsMemberUnusedIamUserAccessKeyDetails:
+				details := map[string]any{
+					"access_key_id": aws.ToString(v.Value.AccessKeyId),
+				}
+				if v := v.Value.LastAccessed; v != nil {
+					details["last_accessed"] = aws.ToTime(v).Format(time.RFC3339)
+				}
+				unusedIAMUserAccessKeyDetails = append(unusedIAMUserAccessKeyDetails, details)
+			case *types.FindingDetailsMemberUnusedIamUserPasswordDetails:
+				details := map[string]any{}
+				if v := v.Value.LastAccessed; v != nil {
+					details["last_accessed"] = aws.ToTime(v).Format(time.RFC3339)
+				}
+				unusedIAMUserPasswordDetails = append(unusedIAMUserPasswordDetails, details)
+			case *types.FindingDetailsMemberUnusedPermissionDetails:
+				details := map[string]any{
+					"actions":           flattenUnusedActions(v.Value.Actions),
+					"service_namespace": aws.ToString(v.Value.ServiceNamespace),
+				}
+				if v := v.Value.LastAccessed; v != nil {
+					details["last_accessed"] = aws.ToTime(v).Format(time.RFC3339)
+				}
+				unusedPermissionDetails = append(unusedPermissionDetails, details)
+			}
+		}
+
+		tfMap["unused_iam_role_details"] = unusedIAMRoleDetails
+		tfMap["unused_iam_user_access_key_details"] = unusedIAMUserAccessKeyDetails
+		tfMap["unused_iam_user_password_details"] = unusedIAMUserPasswordDetails
+		tfMap["unused_permission_details"] = unusedPermissionDetails
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenUnusedActions(apiObjects []types.UnusedAction) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrAction: aws.ToString(apiObject.Action),
+		}
+		if v := apiObject.LastAccessed; v != nil {
+			tfMap["last_accessed"] = aws.ToTime(v).Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -5,7 +5,17 @@ package chimesdkmediapipelines
 
 // Exports for use in tests only.
 var (
-	ResourceMediaInsightsPipelineConfiguration = resourceMediaInsightsPipelineConfiguration
+	ResourceMediaCapturePipeline                = resourceMediaCapturePipeline
+	ResourceMediaConcatenationPipeline          = resourceMediaConcatenationPipeline
+	ResourceMediaInsightsPipeline               = resourceMediaInsightsPipeline
+	ResourceMediaInsightsPipelineConfiguration  = resourceMediaInsightsPipelineConfiguration
+	ResourceMediaLiveConnectorPipeline          = resourceMediaLiveConnectorPipeline
+	ResourceMediaPipelineKinesisVideoStreamPool = resourceMediaPipelineKinesisVideoStreamPool
 
-	FindMediaInsightsPipelineConfigurationByID = findMediaInsightsPipelineConfigurationByID
+	FindMediaCapturePipelineByID                = findMediaCapturePipelineByID
+	FindMediaConcatenationPipelineByID          = findMediaConcatenationPipelineByID
+	FindMediaInsightsPipelineByID               = findMediaInsightsPipelineByID
+	FindMediaInsightsPipelineConfigurationByID  = findMediaInsightsPipelineConfigurationByID
+	FindMediaLiveConnectorPipelineByID          = findMediaLiveConnectorPipelineByID
+	FindMediaPipelineKinesisVideoStreamPoolByID = findMediaPipelineKinesisVideoStreamPoolByID
 )
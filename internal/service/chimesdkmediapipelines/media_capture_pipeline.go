@@ -0,0 +1,631 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameMediaCapturePipeline = "Media Capture Pipeline"
+)
+
+// @SDKResource("aws_chimesdkmediapipelines_media_capture_pipeline", name="Media Capture Pipeline")
+// @Tags(identifierAttribute="arn")
+func resourceMediaCapturePipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMediaCapturePipelineCreate,
+		ReadWithoutTimeout:   resourceMediaCapturePipelineRead,
+		UpdateWithoutTimeout: resourceMediaCapturePipelineUpdate,
+		DeleteWithoutTimeout: resourceMediaCapturePipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(iamPropagationTimeout),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"chime_sdk_meeting_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"artifacts_configuration": ArtifactsConfigurationSchema(),
+						"source_configuration":    SourceConfigurationSchema(),
+					},
+				},
+			},
+			"sink_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sink_iam_role_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sink_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.MediaPipelineSinkType](),
+			},
+			"source_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"source_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.MediaPipelineSourceType](),
+			},
+			"sse_aws_key_management_params": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"aws_kms_encryption_context": {
+							Type:     schema.TypeString,
+							Optional: true,
+							ForceNew: true,
+						},
+						"aws_kms_key_id": {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func ArtifactsConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"audio": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"mux_type": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.AudioMuxType](),
+							},
+						},
+					},
+				},
+				"composited_video": CompositedVideoArtifactsConfigurationSchema(),
+				"content":          ArtifactsStateSchema(enum.Validate[awstypes.ContentMuxType]()),
+				"video":            ArtifactsStateSchema(enum.Validate[awstypes.VideoMuxType]()),
+			},
+		},
+	}
+}
+
+func CompositedVideoArtifactsConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"grid_view_configuration": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"canvas_orientation": {
+								Type:             schema.TypeString,
+								Optional:         true,
+								Computed:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.CanvasOrientation](),
+							},
+							"content_share_layout": {
+								Type:             schema.TypeString,
+								Required:         true,
+								ForceNew:         true,
+								ValidateDiagFunc: enum.Validate[awstypes.ContentShareLayoutOption](),
+							},
+						},
+					},
+				},
+				"layout": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.LayoutOption](),
+				},
+				"resolution": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					Computed:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.ResolutionOption](),
+				},
+			},
+		},
+	}
+}
+
+func SourceConfigurationSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"selected_video_streams": {
+					Type:     schema.TypeList,
+					Required: true,
+					ForceNew: true,
+					MaxItems: 1,
+					Elem: &schema.Resource{
+						Schema: map[string]*schema.Schema{
+							"attendee_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 25,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+							"external_user_ids": {
+								Type:     schema.TypeSet,
+								Optional: true,
+								ForceNew: true,
+								MaxItems: 25,
+								Elem:     &schema.Schema{Type: schema.TypeString},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func ArtifactsStateSchema(validateMuxType schema.SchemaValidateDiagFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"mux_type": {
+					Type:             schema.TypeString,
+					Optional:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: validateMuxType,
+				},
+				names.AttrState: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: enum.Validate[awstypes.ArtifactsState](),
+				},
+			},
+		},
+	}
+}
+
+func resourceMediaCapturePipelineCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	sourceARN := d.Get("source_arn").(string)
+	in := &chimesdkmediapipelines.CreateMediaCapturePipelineInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		SinkArn:            aws.String(d.Get("sink_arn").(string)),
+		SinkType:           awstypes.MediaPipelineSinkType(d.Get("sink_type").(string)),
+		SourceArn:          aws.String(sourceARN),
+		SourceType:         awstypes.MediaPipelineSourceType(d.Get("source_type").(string)),
+		Tags:               getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("chime_sdk_meeting_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		in.ChimeSdkMeetingConfiguration = expandChimeSDKMeetingConfiguration(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("sink_iam_role_arn"); ok {
+		in.SinkIamRoleArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("sse_aws_key_management_params"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		in.SseAwsKeyManagementParams = &awstypes.SseAwsKeyManagementParams{
+			AwsKmsKeyId: aws.String(tfMap["aws_kms_key_id"].(string)),
+		}
+		if v, ok := tfMap["aws_kms_encryption_context"].(string); ok && v != "" {
+			in.SseAwsKeyManagementParams.AwsKmsEncryptionContext = aws.String(v)
+		}
+	}
+
+	// Retry when forbidden exception is received; iam role propagation is eventually consistent
+	var out *chimesdkmediapipelines.CreateMediaCapturePipelineOutput
+	createError := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var err error
+		out, err = conn.CreateMediaCapturePipeline(ctx, in)
+		if err != nil {
+			var forbiddenException *awstypes.ForbiddenException
+			if errors.As(err, &forbiddenException) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if createError != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaCapturePipeline, sourceARN, createError)
+	}
+
+	if out == nil || out.MediaCapturePipeline == nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaCapturePipeline, sourceARN, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.MediaCapturePipeline.MediaPipelineId))
+
+	return append(diags, resourceMediaCapturePipelineRead(ctx, d, meta)...)
+}
+
+func resourceMediaCapturePipelineRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	out, err := findMediaCapturePipelineByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ChimeSDKMediaPipelines MediaCapturePipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionReading, ResNameMediaCapturePipeline, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.MediaPipelineArn)
+	if err := d.Set("chime_sdk_meeting_configuration", flattenChimeSDKMeetingConfiguration(out.ChimeSdkMeetingConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting chime_sdk_meeting_configuration: %s", err)
+	}
+	d.Set("sink_arn", out.SinkArn)
+	d.Set("sink_iam_role_arn", out.SinkIamRoleArn)
+	d.Set("sink_type", out.SinkType)
+	d.Set("source_arn", out.SourceArn)
+	d.Set("source_type", out.SourceType)
+	if v := out.SseAwsKeyManagementParams; v != nil {
+		if err := d.Set("sse_aws_key_management_params", []any{map[string]any{
+			"aws_kms_encryption_context": aws.ToString(v.AwsKmsEncryptionContext),
+			"aws_kms_key_id":             aws.ToString(v.AwsKmsKeyId),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting sse_aws_key_management_params: %s", err)
+		}
+	} else {
+		d.Set("sse_aws_key_management_params", nil)
+	}
+	d.Set(names.AttrStatus, out.Status)
+
+	return diags
+}
+
+func resourceMediaCapturePipelineUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Tags only.
+	return resourceMediaCapturePipelineRead(ctx, d, meta)
+}
+
+func resourceMediaCapturePipelineDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	log.Printf("[INFO] Deleting ChimeSDKMediaPipelines MediaCapturePipeline %s", d.Id())
+	_, err := conn.DeleteMediaCapturePipeline(ctx, &chimesdkmediapipelines.DeleteMediaCapturePipelineInput{
+		MediaPipelineId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionDeleting, ResNameMediaCapturePipeline, d.Id(), err)
+	}
+
+	if _, err := waitMediaCapturePipelineDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionWaitingForDeletion, ResNameMediaCapturePipeline, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMediaCapturePipelineByID(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) (*awstypes.MediaCapturePipeline, error) {
+	in := &chimesdkmediapipelines.GetMediaCapturePipelineInput{
+		MediaPipelineId: aws.String(id),
+	}
+	out, err := conn.GetMediaCapturePipeline(ctx, in)
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.MediaCapturePipeline == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.MediaCapturePipeline, nil
+}
+
+func statusMediaCapturePipeline(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		out, err := findMediaCapturePipelineByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitMediaCapturePipelineDeleted(ctx context.Context, conn *chimesdkmediapipelines.Client, id string, timeout time.Duration) (*awstypes.MediaCapturePipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MediaPipelineStatusInitializing, awstypes.MediaPipelineStatusInProgress, awstypes.MediaPipelineStatusStopping, awstypes.MediaPipelineStatusStopped),
+		Target:  []string{},
+		Refresh: statusMediaCapturePipeline(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*awstypes.MediaCapturePipeline); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandChimeSDKMeetingConfiguration(tfMap map[string]any) *awstypes.ChimeSdkMeetingConfiguration {
+	apiObject := &awstypes.ChimeSdkMeetingConfiguration{}
+
+	if v, ok := tfMap["artifacts_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.ArtifactsConfiguration = expandArtifactsConfiguration(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["source_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.SourceConfiguration = expandSourceConfiguration(v[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func expandSourceConfiguration(tfMap map[string]any) *awstypes.SourceConfiguration {
+	apiObject := &awstypes.SourceConfiguration{}
+
+	if v, ok := tfMap["selected_video_streams"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.SelectedVideoStreams = &awstypes.SelectedVideoStreams{}
+
+		if v, ok := tfMap["attendee_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.SelectedVideoStreams.AttendeeIds = flex.ExpandStringValueSet(v)
+		}
+
+		if v, ok := tfMap["external_user_ids"].(*schema.Set); ok && v.Len() > 0 {
+			apiObject.SelectedVideoStreams.ExternalUserIds = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	return apiObject
+}
+
+func expandArtifactsConfiguration(tfMap map[string]any) *awstypes.ArtifactsConfiguration {
+	apiObject := &awstypes.ArtifactsConfiguration{}
+
+	if v, ok := tfMap["audio"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Audio = &awstypes.AudioArtifactsConfiguration{
+			MuxType: awstypes.AudioMuxType(v[0].(map[string]any)["mux_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["composited_video"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.CompositedVideo = expandCompositedVideoArtifactsConfiguration(v[0].(map[string]any))
+	}
+
+	if v, ok := tfMap["content"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.Content = &awstypes.ContentArtifactsConfiguration{
+			MuxType: awstypes.ContentMuxType(tfMap["mux_type"].(string)),
+			State:   awstypes.ArtifactsState(tfMap[names.AttrState].(string)),
+		}
+	}
+
+	if v, ok := tfMap["video"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.Video = &awstypes.VideoArtifactsConfiguration{
+			MuxType: awstypes.VideoMuxType(tfMap["mux_type"].(string)),
+			State:   awstypes.ArtifactsState(tfMap[names.AttrState].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func expandCompositedVideoArtifactsConfiguration(tfMap map[string]any) *awstypes.CompositedVideoArtifactsConfiguration {
+	apiObject := &awstypes.CompositedVideoArtifactsConfiguration{}
+
+	if v, ok := tfMap["grid_view_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.GridViewConfiguration = &awstypes.GridViewConfiguration{
+			ContentShareLayout: awstypes.ContentShareLayoutOption(tfMap["content_share_layout"].(string)),
+		}
+
+		if v, ok := tfMap["canvas_orientation"].(string); ok && v != "" {
+			apiObject.GridViewConfiguration.CanvasOrientation = awstypes.CanvasOrientation(v)
+		}
+	}
+
+	if v, ok := tfMap["layout"].(string); ok && v != "" {
+		apiObject.Layout = awstypes.LayoutOption(v)
+	}
+
+	if v, ok := tfMap["resolution"].(string); ok && v != "" {
+		apiObject.Resolution = awstypes.ResolutionOption(v)
+	}
+
+	return apiObject
+}
+
+func flattenChimeSDKMeetingConfiguration(apiObject *awstypes.ChimeSdkMeetingConfiguration) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.ArtifactsConfiguration; v != nil {
+		tfMap["artifacts_configuration"] = flattenArtifactsConfiguration(v)
+	}
+
+	if v := apiObject.SourceConfiguration; v != nil {
+		tfMap["source_configuration"] = flattenSourceConfiguration(v)
+	}
+
+	return []any{tfMap}
+}
+
+func flattenSourceConfiguration(apiObject *awstypes.SourceConfiguration) []any {
+	if apiObject == nil || apiObject.SelectedVideoStreams == nil {
+		return nil
+	}
+
+	return []any{map[string]any{
+		"selected_video_streams": []any{map[string]any{
+			"attendee_ids":      apiObject.SelectedVideoStreams.AttendeeIds,
+			"external_user_ids": apiObject.SelectedVideoStreams.ExternalUserIds,
+		}},
+	}}
+}
+
+func flattenArtifactsConfiguration(apiObject *awstypes.ArtifactsConfiguration) []any {
+	tfMap := map[string]any{}
+
+	if v := apiObject.Audio; v != nil {
+		tfMap["audio"] = []any{map[string]any{
+			"mux_type": v.MuxType,
+		}}
+	}
+
+	if v := apiObject.CompositedVideo; v != nil {
+		tfMap["composited_video"] = flattenCompositedVideoArtifactsConfiguration(v)
+	}
+
+	if v := apiObject.Content; v != nil {
+		tfMap["content"] = []any{map[string]any{
+			"mux_type":      v.MuxType,
+			names.AttrState: v.State,
+		}}
+	}
+
+	if v := apiObject.Video; v != nil {
+		tfMap["video"] = []any{map[string]any{
+			"mux_type":      v.MuxType,
+			names.AttrState: v.State,
+		}}
+	}
+
+	return []any{tfMap}
+}
+
+func flattenCompositedVideoArtifactsConfiguration(apiObject *awstypes.CompositedVideoArtifactsConfiguration) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"layout":     apiObject.Layout,
+		"resolution": apiObject.Resolution,
+	}
+
+	if v := apiObject.GridViewConfiguration; v != nil {
+		tfMap["grid_view_configuration"] = []any{map[string]any{
+			"canvas_orientation":   v.CanvasOrientation,
+			"content_share_layout": v.ContentShareLayout,
+		}}
+	}
+
+	return []any{tfMap}
+}
@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfchimesdkmediapipelines "github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Media capture pipelines require an active Amazon Chime SDK meeting, which cannot be managed by Terraform.
+func TestAccChimeSDKMediaPipelinesMediaCapturePipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	meetingARN := acctest.SkipIfEnvVarNotSet(t, "AWS_CHIMESDK_MEETING_ARN")
+	var pipeline awstypes.MediaCapturePipeline
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_capture_pipeline.test"
+	dataSourceName := "data.aws_chimesdkmediapipelines_media_pipeline.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaCapturePipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaCapturePipelineConfig_basic(rName, meetingARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaCapturePipelineExists(ctx, resourceName, &pipeline),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "chime_sdk_meeting_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "chime_sdk_meeting_configuration.0.artifacts_configuration.0.audio.0.mux_type", string(awstypes.AudioMuxTypeAudioOnly)),
+					resource.TestCheckResourceAttr(resourceName, "sink_type", string(awstypes.MediaPipelineSinkTypeS3Bucket)),
+					resource.TestCheckResourceAttr(resourceName, "source_arn", meetingARN),
+					resource.TestCheckResourceAttr(resourceName, "source_type", string(awstypes.MediaPipelineSourceTypeChimeSdkMeeting)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "media_pipeline_type", "MediaCapturePipeline"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "sink_arn", resourceName, "sink_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "source_arn", resourceName, "source_arn"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMediaCapturePipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chimesdkmediapipelines_media_capture_pipeline" {
+				continue
+			}
+
+			_, err := tfchimesdkmediapipelines.FindMediaCapturePipelineByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingDestroyed,
+				tfchimesdkmediapipelines.ResNameMediaCapturePipeline, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMediaCapturePipelineExists(ctx context.Context, name string, v *awstypes.MediaCapturePipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaCapturePipeline, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+		resp, err := tfchimesdkmediapipelines.FindMediaCapturePipelineByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaCapturePipeline, rs.Primary.ID, err)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccMediaCapturePipelineConfig_basic(rName, meetingARN string) string {
+	return fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+data "aws_partition" "current" {}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_bucket_policy" "test" {
+  bucket = aws_s3_bucket.test.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "mediapipelines.chime.amazonaws.com" }
+      Action    = ["s3:PutObject", "s3:PutObjectAcl"]
+      Resource  = "${aws_s3_bucket.test.arn}/*"
+      Condition = {
+        StringEquals = { "aws:SourceAccount" = data.aws_caller_identity.current.account_id }
+      }
+    }]
+  })
+}
+
+resource "aws_chimesdkmediapipelines_media_capture_pipeline" "test" {
+  source_type = "ChimeSdkMeeting"
+  source_arn  = %[2]q
+  sink_type   = "S3Bucket"
+  sink_arn    = aws_s3_bucket.test.arn
+
+  chime_sdk_meeting_configuration {
+    artifacts_configuration {
+      audio {
+        mux_type = "AudioOnly"
+      }
+
+      content {
+        state = "Disabled"
+      }
+
+      video {
+        state = "Disabled"
+      }
+    }
+  }
+
+  depends_on = [aws_s3_bucket_policy.test]
+}
+
+data "aws_chimesdkmediapipelines_media_pipeline" "test" {
+  media_pipeline_id = aws_chimesdkmediapipelines_media_capture_pipeline.test.id
+}
+`, rName, meetingARN)
+}
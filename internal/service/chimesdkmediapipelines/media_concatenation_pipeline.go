@@ -0,0 +1,491 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameMediaConcatenationPipeline = "Media Concatenation Pipeline"
+)
+
+// @SDKResource("aws_chimesdkmediapipelines_media_concatenation_pipeline", name="Media Concatenation Pipeline")
+// @Tags(identifierAttribute="arn")
+func resourceMediaConcatenationPipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMediaConcatenationPipelineCreate,
+		ReadWithoutTimeout:   resourceMediaConcatenationPipelineRead,
+		UpdateWithoutTimeout: resourceMediaConcatenationPipelineUpdate,
+		DeleteWithoutTimeout: resourceMediaConcatenationPipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(iamPropagationTimeout),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"s3_bucket_sink_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrDestination: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          awstypes.ConcatenationSinkTypeS3Bucket,
+							ValidateDiagFunc: enum.Validate[awstypes.ConcatenationSinkType](),
+						},
+					},
+				},
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"media_capture_pipeline_source_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"chime_sdk_meeting_configuration": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"artifacts_configuration": {
+													Type:     schema.TypeList,
+													Required: true,
+													ForceNew: true,
+													MaxItems: 1,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"audio":                  ArtifactsConcatenationStateSchema(enum.Validate[awstypes.AudioArtifactsConcatenationState]()),
+															"composited_video":       ArtifactsConcatenationStateSchema(enum.Validate[awstypes.ArtifactsConcatenationState]()),
+															names.AttrContent:        ArtifactsConcatenationStateSchema(enum.Validate[awstypes.ArtifactsConcatenationState]()),
+															"data_channel":           ArtifactsConcatenationStateSchema(enum.Validate[awstypes.ArtifactsConcatenationState]()),
+															"meeting_events":         ArtifactsConcatenationStateSchema(enum.Validate[awstypes.ArtifactsConcatenationState]()),
+															"transcription_messages": ArtifactsConcatenationStateSchema(enum.Validate[awstypes.ArtifactsConcatenationState]()),
+															"video":                  ArtifactsConcatenationStateSchema(enum.Validate[awstypes.ArtifactsConcatenationState]()),
+														},
+													},
+												},
+											},
+										},
+									},
+									"media_pipeline_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						names.AttrType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          awstypes.ConcatenationSourceTypeMediaCapturePipeline,
+							ValidateDiagFunc: enum.Validate[awstypes.ConcatenationSourceType](),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func ArtifactsConcatenationStateSchema(validateState schema.SchemaValidateDiagFunc) *schema.Schema {
+	return &schema.Schema{
+		Type:     schema.TypeList,
+		Required: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				names.AttrState: {
+					Type:             schema.TypeString,
+					Required:         true,
+					ForceNew:         true,
+					ValidateDiagFunc: validateState,
+				},
+			},
+		},
+	}
+}
+
+func resourceMediaConcatenationPipelineCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	in := &chimesdkmediapipelines.CreateMediaConcatenationPipelineInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Sinks:              expandConcatenationSinks(d.Get("sink").([]any)),
+		Sources:            expandConcatenationSources(d.Get(names.AttrSource).([]any)),
+		Tags:               getTagsIn(ctx),
+	}
+
+	// Retry when forbidden exception is received; iam role propagation is eventually consistent
+	var out *chimesdkmediapipelines.CreateMediaConcatenationPipelineOutput
+	createError := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var err error
+		out, err = conn.CreateMediaConcatenationPipeline(ctx, in)
+		if err != nil {
+			var forbiddenException *awstypes.ForbiddenException
+			if errors.As(err, &forbiddenException) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if createError != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaConcatenationPipeline, "", createError)
+	}
+
+	if out == nil || out.MediaConcatenationPipeline == nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaConcatenationPipeline, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.MediaConcatenationPipeline.MediaPipelineId))
+
+	return append(diags, resourceMediaConcatenationPipelineRead(ctx, d, meta)...)
+}
+
+func resourceMediaConcatenationPipelineRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	out, err := findMediaConcatenationPipelineByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ChimeSDKMediaPipelines MediaConcatenationPipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionReading, ResNameMediaConcatenationPipeline, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.MediaPipelineArn)
+	if err := d.Set("sink", flattenConcatenationSinks(out.Sinks)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sink: %s", err)
+	}
+	if err := d.Set(names.AttrSource, flattenConcatenationSources(out.Sources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	d.Set(names.AttrStatus, out.Status)
+
+	return diags
+}
+
+func resourceMediaConcatenationPipelineUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Tags only.
+	return resourceMediaConcatenationPipelineRead(ctx, d, meta)
+}
+
+func resourceMediaConcatenationPipelineDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	log.Printf("[INFO] Deleting ChimeSDKMediaPipelines MediaConcatenationPipeline %s", d.Id())
+	_, err := conn.DeleteMediaPipeline(ctx, &chimesdkmediapipelines.DeleteMediaPipelineInput{
+		MediaPipelineId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionDeleting, ResNameMediaConcatenationPipeline, d.Id(), err)
+	}
+
+	if _, err := waitMediaConcatenationPipelineDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionWaitingForDeletion, ResNameMediaConcatenationPipeline, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMediaConcatenationPipelineByID(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) (*awstypes.MediaConcatenationPipeline, error) {
+	out, err := findMediaPipelineByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.MediaConcatenationPipeline == nil {
+		return nil, tfresource.NewEmptyResultError(id)
+	}
+
+	return out.MediaConcatenationPipeline, nil
+}
+
+func statusMediaConcatenationPipeline(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		out, err := findMediaConcatenationPipelineByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitMediaConcatenationPipelineDeleted(ctx context.Context, conn *chimesdkmediapipelines.Client, id string, timeout time.Duration) (*awstypes.MediaConcatenationPipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MediaPipelineStatusInitializing, awstypes.MediaPipelineStatusInProgress, awstypes.MediaPipelineStatusStopping, awstypes.MediaPipelineStatusStopped),
+		Target:  []string{},
+		Refresh: statusMediaConcatenationPipeline(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*awstypes.MediaConcatenationPipeline); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandConcatenationSinks(tfList []any) []awstypes.ConcatenationSink {
+	var apiObjects []awstypes.ConcatenationSink
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ConcatenationSink{
+			Type: awstypes.ConcatenationSinkType(tfMap[names.AttrType].(string)),
+		}
+
+		if v, ok := tfMap["s3_bucket_sink_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			apiObject.S3BucketSinkConfiguration = &awstypes.S3BucketSinkConfiguration{
+				Destination: aws.String(v[0].(map[string]any)[names.AttrDestination].(string)),
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandConcatenationSources(tfList []any) []awstypes.ConcatenationSource {
+	var apiObjects []awstypes.ConcatenationSource
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.ConcatenationSource{
+			Type: awstypes.ConcatenationSourceType(tfMap[names.AttrType].(string)),
+		}
+
+		if v, ok := tfMap["media_capture_pipeline_source_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]any)
+			apiObject.MediaCapturePipelineSourceConfiguration = &awstypes.MediaCapturePipelineSourceConfiguration{
+				MediaPipelineArn: aws.String(tfMap["media_pipeline_arn"].(string)),
+			}
+
+			if v, ok := tfMap["chime_sdk_meeting_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+				apiObject.MediaCapturePipelineSourceConfiguration.ChimeSdkMeetingConfiguration = &awstypes.ChimeSdkMeetingConcatenationConfiguration{}
+
+				if v, ok := v[0].(map[string]any)["artifacts_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+					apiObject.MediaCapturePipelineSourceConfiguration.ChimeSdkMeetingConfiguration.ArtifactsConfiguration = expandArtifactsConcatenationConfiguration(v[0].(map[string]any))
+				}
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandArtifactsConcatenationConfiguration(tfMap map[string]any) *awstypes.ArtifactsConcatenationConfiguration {
+	state := func(k string) awstypes.ArtifactsConcatenationState {
+		if v, ok := tfMap[k].([]any); ok && len(v) > 0 && v[0] != nil {
+			return awstypes.ArtifactsConcatenationState(v[0].(map[string]any)[names.AttrState].(string))
+		}
+
+		return ""
+	}
+
+	apiObject := &awstypes.ArtifactsConcatenationConfiguration{
+		CompositedVideo:       &awstypes.CompositedVideoConcatenationConfiguration{State: state("composited_video")},
+		Content:               &awstypes.ContentConcatenationConfiguration{State: state(names.AttrContent)},
+		DataChannel:           &awstypes.DataChannelConcatenationConfiguration{State: state("data_channel")},
+		MeetingEvents:         &awstypes.MeetingEventsConcatenationConfiguration{State: state("meeting_events")},
+		TranscriptionMessages: &awstypes.TranscriptionMessagesConcatenationConfiguration{State: state("transcription_messages")},
+		Video:                 &awstypes.VideoConcatenationConfiguration{State: state("video")},
+	}
+
+	if v, ok := tfMap["audio"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.Audio = &awstypes.AudioConcatenationConfiguration{
+			State: awstypes.AudioArtifactsConcatenationState(v[0].(map[string]any)[names.AttrState].(string)),
+		}
+	}
+
+	return apiObject
+}
+
+func flattenConcatenationSinks(apiObjects []awstypes.ConcatenationSink) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrType: apiObject.Type,
+		}
+
+		if v := apiObject.S3BucketSinkConfiguration; v != nil {
+			tfMap["s3_bucket_sink_configuration"] = []any{map[string]any{
+				names.AttrDestination: aws.ToString(v.Destination),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenConcatenationSources(apiObjects []awstypes.ConcatenationSource) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			names.AttrType: apiObject.Type,
+		}
+
+		if v := apiObject.MediaCapturePipelineSourceConfiguration; v != nil {
+			tfMapSourceConfiguration := map[string]any{
+				"media_pipeline_arn": aws.ToString(v.MediaPipelineArn),
+			}
+
+			if v := v.ChimeSdkMeetingConfiguration; v != nil && v.ArtifactsConfiguration != nil {
+				tfMapSourceConfiguration["chime_sdk_meeting_configuration"] = []any{map[string]any{
+					"artifacts_configuration": flattenArtifactsConcatenationConfiguration(v.ArtifactsConfiguration),
+				}}
+			}
+
+			tfMap["media_capture_pipeline_source_configuration"] = []any{tfMapSourceConfiguration}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenArtifactsConcatenationConfiguration(apiObject *awstypes.ArtifactsConcatenationConfiguration) []any {
+	state := func(v any) []any {
+		return []any{map[string]any{
+			names.AttrState: v,
+		}}
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.Audio; v != nil {
+		tfMap["audio"] = state(v.State)
+	}
+	if v := apiObject.CompositedVideo; v != nil {
+		tfMap["composited_video"] = state(v.State)
+	}
+	if v := apiObject.Content; v != nil {
+		tfMap[names.AttrContent] = state(v.State)
+	}
+	if v := apiObject.DataChannel; v != nil {
+		tfMap["data_channel"] = state(v.State)
+	}
+	if v := apiObject.MeetingEvents; v != nil {
+		tfMap["meeting_events"] = state(v.State)
+	}
+	if v := apiObject.TranscriptionMessages; v != nil {
+		tfMap["transcription_messages"] = state(v.State)
+	}
+	if v := apiObject.Video; v != nil {
+		tfMap["video"] = state(v.State)
+	}
+
+	return []any{tfMap}
+}
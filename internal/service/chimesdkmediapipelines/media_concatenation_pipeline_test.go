@@ -0,0 +1,161 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfchimesdkmediapipelines "github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Media concatenation pipelines require a media capture pipeline, which in turn requires an active Amazon Chime SDK meeting.
+func TestAccChimeSDKMediaPipelinesMediaConcatenationPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	meetingARN := acctest.SkipIfEnvVarNotSet(t, "AWS_CHIMESDK_MEETING_ARN")
+	var pipeline awstypes.MediaConcatenationPipeline
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_concatenation_pipeline.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaConcatenationPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaConcatenationPipelineConfig_basic(rName, meetingARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaConcatenationPipelineExists(ctx, resourceName, &pipeline),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sink.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "sink.0.s3_bucket_sink_configuration.0.destination", "aws_s3_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sink.0.type", string(awstypes.ConcatenationSinkTypeS3Bucket)),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "source.0.media_capture_pipeline_source_configuration.0.media_pipeline_arn", "aws_chimesdkmediapipelines_media_capture_pipeline.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "source.0.media_capture_pipeline_source_configuration.0.chime_sdk_meeting_configuration.0.artifacts_configuration.0.audio.0.state", string(awstypes.AudioArtifactsConcatenationStateEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "source.0.type", string(awstypes.ConcatenationSourceTypeMediaCapturePipeline)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMediaConcatenationPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chimesdkmediapipelines_media_concatenation_pipeline" {
+				continue
+			}
+
+			_, err := tfchimesdkmediapipelines.FindMediaConcatenationPipelineByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingDestroyed,
+				tfchimesdkmediapipelines.ResNameMediaConcatenationPipeline, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMediaConcatenationPipelineExists(ctx context.Context, name string, v *awstypes.MediaConcatenationPipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaConcatenationPipeline, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+		resp, err := tfchimesdkmediapipelines.FindMediaConcatenationPipelineByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaConcatenationPipeline, rs.Primary.ID, err)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccMediaConcatenationPipelineConfig_basic(rName, meetingARN string) string {
+	return acctest.ConfigCompose(testAccMediaCapturePipelineConfig_basic(rName, meetingARN), `
+resource "aws_chimesdkmediapipelines_media_concatenation_pipeline" "test" {
+  sink {
+    s3_bucket_sink_configuration {
+      destination = aws_s3_bucket.test.arn
+    }
+  }
+
+  source {
+    media_capture_pipeline_source_configuration {
+      media_pipeline_arn = aws_chimesdkmediapipelines_media_capture_pipeline.test.arn
+
+      chime_sdk_meeting_configuration {
+        artifacts_configuration {
+          audio {
+            state = "Enabled"
+          }
+
+          composited_video {
+            state = "Disabled"
+          }
+
+          content {
+            state = "Disabled"
+          }
+
+          data_channel {
+            state = "Disabled"
+          }
+
+          meeting_events {
+            state = "Disabled"
+          }
+
+          transcription_messages {
+            state = "Disabled"
+          }
+
+          video {
+            state = "Disabled"
+          }
+        }
+      }
+    }
+  }
+}
+`)
+}
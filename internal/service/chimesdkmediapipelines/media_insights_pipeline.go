@@ -0,0 +1,624 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameMediaInsightsPipeline = "Media Insights Pipeline"
+)
+
+// @SDKResource("aws_chimesdkmediapipelines_media_insights_pipeline", name="Media Insights Pipeline")
+// @Tags(identifierAttribute="arn")
+func resourceMediaInsightsPipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMediaInsightsPipelineCreate,
+		ReadWithoutTimeout:   resourceMediaInsightsPipelineRead,
+		UpdateWithoutTimeout: resourceMediaInsightsPipelineUpdate,
+		DeleteWithoutTimeout: resourceMediaInsightsPipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(iamPropagationTimeout),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"element_statuses": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrStatus: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrType: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"kinesis_video_stream_recording_source_runtime_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fragment_selector": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fragment_selector_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.FragmentSelectorType](),
+									},
+									"timestamp_range": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"end_timestamp": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+												"start_timestamp": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IsRFC3339Time,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"streams": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"kinesis_video_stream_source_runtime_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"media_encoding": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.MediaEncoding](),
+						},
+						"media_sample_rate": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: validation.IntBetween(8000, 48000),
+						},
+						"streams": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MinItems: 1,
+							MaxItems: 2,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fragment_number": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"stream_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"stream_channel_definition": {
+										Type:     schema.TypeList,
+										Required: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"channel_definitions": {
+													Type:     schema.TypeList,
+													Optional: true,
+													ForceNew: true,
+													MaxItems: 2,
+													Elem: &schema.Resource{
+														Schema: map[string]*schema.Schema{
+															"channel_id": {
+																Type:         schema.TypeInt,
+																Required:     true,
+																ForceNew:     true,
+																ValidateFunc: validation.IntBetween(0, 1),
+															},
+															"participant_role": {
+																Type:             schema.TypeString,
+																Optional:         true,
+																ForceNew:         true,
+																ValidateDiagFunc: enum.Validate[awstypes.ParticipantRole](),
+															},
+														},
+													},
+												},
+												"number_of_channels": {
+													Type:         schema.TypeInt,
+													Required:     true,
+													ForceNew:     true,
+													ValidateFunc: validation.IntBetween(1, 2),
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"media_insights_pipeline_configuration_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"media_insights_runtime_metadata": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"s3_recording_sink_runtime_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrDestination: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: verify.ValidARN,
+						},
+						"recording_file_format": {
+							Type:             schema.TypeString,
+							Required:         true,
+							ForceNew:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.RecordingFileFormat](),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceMediaInsightsPipelineCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	in := &chimesdkmediapipelines.CreateMediaInsightsPipelineInput{
+		ClientRequestToken:                    aws.String(id.UniqueId()),
+		MediaInsightsPipelineConfigurationArn: aws.String(d.Get("media_insights_pipeline_configuration_arn").(string)),
+		Tags:                                  getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("kinesis_video_stream_recording_source_runtime_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		in.KinesisVideoStreamRecordingSourceRuntimeConfiguration = expandKinesisVideoStreamRecordingSourceRuntimeConfiguration(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("kinesis_video_stream_source_runtime_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		in.KinesisVideoStreamSourceRuntimeConfiguration = expandKinesisVideoStreamSourceRuntimeConfiguration(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("media_insights_runtime_metadata"); ok && len(v.(map[string]any)) > 0 {
+		in.MediaInsightsRuntimeMetadata = flex.ExpandStringValueMap(v.(map[string]any))
+	}
+
+	if v, ok := d.GetOk("s3_recording_sink_runtime_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		in.S3RecordingSinkRuntimeConfiguration = &awstypes.S3RecordingSinkRuntimeConfiguration{
+			Destination:         aws.String(tfMap[names.AttrDestination].(string)),
+			RecordingFileFormat: awstypes.RecordingFileFormat(tfMap["recording_file_format"].(string)),
+		}
+	}
+
+	// Retry when forbidden exception is received; iam role propagation is eventually consistent
+	var out *chimesdkmediapipelines.CreateMediaInsightsPipelineOutput
+	createError := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var err error
+		out, err = conn.CreateMediaInsightsPipeline(ctx, in)
+		if err != nil {
+			var forbiddenException *awstypes.ForbiddenException
+			if errors.As(err, &forbiddenException) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if createError != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaInsightsPipeline, "", createError)
+	}
+
+	if out == nil || out.MediaInsightsPipeline == nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaInsightsPipeline, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.MediaInsightsPipeline.MediaPipelineId))
+
+	return append(diags, resourceMediaInsightsPipelineRead(ctx, d, meta)...)
+}
+
+func resourceMediaInsightsPipelineRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	out, err := findMediaInsightsPipelineByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ChimeSDKMediaPipelines MediaInsightsPipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionReading, ResNameMediaInsightsPipeline, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.MediaPipelineArn)
+	if err := d.Set("element_statuses", flattenMediaInsightsPipelineElementStatuses(out.ElementStatuses)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting element_statuses: %s", err)
+	}
+	if err := d.Set("kinesis_video_stream_recording_source_runtime_configuration", flattenKinesisVideoStreamRecordingSourceRuntimeConfiguration(out.KinesisVideoStreamRecordingSourceRuntimeConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting kinesis_video_stream_recording_source_runtime_configuration: %s", err)
+	}
+	if err := d.Set("kinesis_video_stream_source_runtime_configuration", flattenKinesisVideoStreamSourceRuntimeConfiguration(out.KinesisVideoStreamSourceRuntimeConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting kinesis_video_stream_source_runtime_configuration: %s", err)
+	}
+	d.Set("media_insights_pipeline_configuration_arn", out.MediaInsightsPipelineConfigurationArn)
+	d.Set("media_insights_runtime_metadata", out.MediaInsightsRuntimeMetadata)
+	if v := out.S3RecordingSinkRuntimeConfiguration; v != nil {
+		if err := d.Set("s3_recording_sink_runtime_configuration", []any{map[string]any{
+			names.AttrDestination:   aws.ToString(v.Destination),
+			"recording_file_format": v.RecordingFileFormat,
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting s3_recording_sink_runtime_configuration: %s", err)
+		}
+	} else {
+		d.Set("s3_recording_sink_runtime_configuration", nil)
+	}
+	d.Set(names.AttrStatus, out.Status)
+
+	return diags
+}
+
+func resourceMediaInsightsPipelineUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Tags only.
+	return resourceMediaInsightsPipelineRead(ctx, d, meta)
+}
+
+func resourceMediaInsightsPipelineDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	log.Printf("[INFO] Deleting ChimeSDKMediaPipelines MediaInsightsPipeline %s", d.Id())
+	_, err := conn.DeleteMediaPipeline(ctx, &chimesdkmediapipelines.DeleteMediaPipelineInput{
+		MediaPipelineId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionDeleting, ResNameMediaInsightsPipeline, d.Id(), err)
+	}
+
+	if _, err := waitMediaInsightsPipelineDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionWaitingForDeletion, ResNameMediaInsightsPipeline, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMediaInsightsPipelineByID(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) (*awstypes.MediaInsightsPipeline, error) {
+	out, err := findMediaPipelineByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.MediaInsightsPipeline == nil {
+		return nil, tfresource.NewEmptyResultError(id)
+	}
+
+	return out.MediaInsightsPipeline, nil
+}
+
+func statusMediaInsightsPipeline(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		out, err := findMediaInsightsPipelineByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitMediaInsightsPipelineDeleted(ctx context.Context, conn *chimesdkmediapipelines.Client, id string, timeout time.Duration) (*awstypes.MediaInsightsPipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MediaPipelineStatusInitializing, awstypes.MediaPipelineStatusInProgress, awstypes.MediaPipelineStatusStopping, awstypes.MediaPipelineStatusStopped),
+		Target:  []string{},
+		Refresh: statusMediaInsightsPipeline(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*awstypes.MediaInsightsPipeline); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandKinesisVideoStreamRecordingSourceRuntimeConfiguration(tfMap map[string]any) *awstypes.KinesisVideoStreamRecordingSourceRuntimeConfiguration {
+	apiObject := &awstypes.KinesisVideoStreamRecordingSourceRuntimeConfiguration{}
+
+	if v, ok := tfMap["fragment_selector"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.FragmentSelector = &awstypes.FragmentSelector{
+			FragmentSelectorType: awstypes.FragmentSelectorType(tfMap["fragment_selector_type"].(string)),
+		}
+
+		if v, ok := tfMap["timestamp_range"].([]any); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]any)
+			endTimestamp, _ := time.Parse(time.RFC3339, tfMap["end_timestamp"].(string))
+			startTimestamp, _ := time.Parse(time.RFC3339, tfMap["start_timestamp"].(string))
+			apiObject.FragmentSelector.TimestampRange = &awstypes.TimestampRange{
+				EndTimestamp:   aws.Time(endTimestamp),
+				StartTimestamp: aws.Time(startTimestamp),
+			}
+		}
+	}
+
+	if v, ok := tfMap["streams"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			apiObject.Streams = append(apiObject.Streams, awstypes.RecordingStreamConfiguration{
+				StreamArn: aws.String(tfMap["stream_arn"].(string)),
+			})
+		}
+	}
+
+	return apiObject
+}
+
+func expandKinesisVideoStreamSourceRuntimeConfiguration(tfMap map[string]any) *awstypes.KinesisVideoStreamSourceRuntimeConfiguration {
+	apiObject := &awstypes.KinesisVideoStreamSourceRuntimeConfiguration{
+		MediaEncoding:   awstypes.MediaEncoding(tfMap["media_encoding"].(string)),
+		MediaSampleRate: aws.Int32(int32(tfMap["media_sample_rate"].(int))),
+	}
+
+	if v, ok := tfMap["streams"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			stream := awstypes.StreamConfiguration{
+				StreamArn: aws.String(tfMap["stream_arn"].(string)),
+			}
+
+			if v, ok := tfMap["fragment_number"].(string); ok && v != "" {
+				stream.FragmentNumber = aws.String(v)
+			}
+
+			if v, ok := tfMap["stream_channel_definition"].([]any); ok && len(v) > 0 && v[0] != nil {
+				stream.StreamChannelDefinition = expandStreamChannelDefinition(v[0].(map[string]any))
+			}
+
+			apiObject.Streams = append(apiObject.Streams, stream)
+		}
+	}
+
+	return apiObject
+}
+
+func expandStreamChannelDefinition(tfMap map[string]any) *awstypes.StreamChannelDefinition {
+	apiObject := &awstypes.StreamChannelDefinition{
+		NumberOfChannels: aws.Int32(int32(tfMap["number_of_channels"].(int))),
+	}
+
+	if v, ok := tfMap["channel_definitions"].([]any); ok {
+		for _, tfMapRaw := range v {
+			tfMap, ok := tfMapRaw.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			channelDefinition := awstypes.ChannelDefinition{
+				ChannelId: int32(tfMap["channel_id"].(int)),
+			}
+
+			if v, ok := tfMap["participant_role"].(string); ok && v != "" {
+				channelDefinition.ParticipantRole = awstypes.ParticipantRole(v)
+			}
+
+			apiObject.ChannelDefinitions = append(apiObject.ChannelDefinitions, channelDefinition)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenMediaInsightsPipelineElementStatuses(apiObjects []awstypes.MediaInsightsPipelineElementStatus) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			names.AttrStatus: apiObject.Status,
+			names.AttrType:   apiObject.Type,
+		})
+	}
+
+	return tfList
+}
+
+func flattenKinesisVideoStreamRecordingSourceRuntimeConfiguration(apiObject *awstypes.KinesisVideoStreamRecordingSourceRuntimeConfiguration) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.FragmentSelector; v != nil {
+		fragmentSelector := map[string]any{
+			"fragment_selector_type": v.FragmentSelectorType,
+		}
+
+		if v := v.TimestampRange; v != nil {
+			fragmentSelector["timestamp_range"] = []any{map[string]any{
+				"end_timestamp":   aws.ToTime(v.EndTimestamp).Format(time.RFC3339),
+				"start_timestamp": aws.ToTime(v.StartTimestamp).Format(time.RFC3339),
+			}}
+		}
+
+		tfMap["fragment_selector"] = []any{fragmentSelector}
+	}
+
+	streams := make([]any, 0, len(apiObject.Streams))
+	for _, v := range apiObject.Streams {
+		streams = append(streams, map[string]any{
+			"stream_arn": aws.ToString(v.StreamArn),
+		})
+	}
+	tfMap["streams"] = streams
+
+	return []any{tfMap}
+}
+
+func flattenKinesisVideoStreamSourceRuntimeConfiguration(apiObject *awstypes.KinesisVideoStreamSourceRuntimeConfiguration) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"media_encoding":    apiObject.MediaEncoding,
+		"media_sample_rate": aws.ToInt32(apiObject.MediaSampleRate),
+	}
+
+	streams := make([]any, 0, len(apiObject.Streams))
+	for _, v := range apiObject.Streams {
+		stream := map[string]any{
+			"fragment_number": aws.ToString(v.FragmentNumber),
+			"stream_arn":      aws.ToString(v.StreamArn),
+		}
+
+		if v := v.StreamChannelDefinition; v != nil {
+			channelDefinitions := make([]any, 0, len(v.ChannelDefinitions))
+			for _, v := range v.ChannelDefinitions {
+				channelDefinitions = append(channelDefinitions, map[string]any{
+					"channel_id":       v.ChannelId,
+					"participant_role": v.ParticipantRole,
+				})
+			}
+
+			stream["stream_channel_definition"] = []any{map[string]any{
+				"channel_definitions": channelDefinitions,
+				"number_of_channels":  aws.ToInt32(v.NumberOfChannels),
+			}}
+		}
+
+		streams = append(streams, stream)
+	}
+	tfMap["streams"] = streams
+
+	return []any{tfMap}
+}
@@ -0,0 +1,80 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameMediaInsightsPipelineConfiguration = "Media Insights Pipeline Configuration Data Source"
+)
+
+// @SDKDataSource("aws_chimesdkmediapipelines_media_insights_pipeline_configuration", name="Media Insights Pipeline Configuration")
+// @Tags(identifierAttribute="arn")
+func dataSourceMediaInsightsPipelineConfiguration() *schema.Resource {
+	resourceSchema := resourceMediaInsightsPipelineConfiguration().Schema
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMediaInsightsPipelineConfigurationRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"elements": sdkv2.ComputedOnlyFromSchema(resourceSchema["elements"]),
+			names.AttrIdentifier: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"real_time_alert_configuration": sdkv2.ComputedOnlyFromSchema(resourceSchema["real_time_alert_configuration"]),
+			"resource_access_role_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func dataSourceMediaInsightsPipelineConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	identifier := d.Get(names.AttrIdentifier).(string)
+	out, err := findMediaInsightsPipelineConfigurationByID(ctx, conn, identifier)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionReading, DSNameMediaInsightsPipelineConfiguration, identifier, err)
+	}
+
+	d.SetId(aws.ToString(out.MediaInsightsPipelineConfigurationId))
+	d.Set(names.AttrARN, out.MediaInsightsPipelineConfigurationArn)
+	if err := d.Set("elements", flattenElements(out.Elements)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting elements: %s", err)
+	}
+	d.Set(names.AttrName, out.MediaInsightsPipelineConfigurationName)
+	if err := d.Set("real_time_alert_configuration", flattenRealTimeAlertConfiguration(out.RealTimeAlertConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting real_time_alert_configuration: %s", err)
+	}
+	d.Set("resource_access_role_arn", out.ResourceAccessRoleArn)
+
+	return diags
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChimeSDKMediaPipelinesMediaInsightsPipelineConfigurationDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	roleName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	streamName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test"
+	dataSourceName := "data.aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: acctest.ConfigCompose(testAccMediaInsightsPipelineConfigurationConfig_voiceAnalytics(rName, roleName, streamName), `
+data "aws_chimesdkmediapipelines_media_insights_pipeline_configuration" "test" {
+  identifier = aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test.arn
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "elements.#", resourceName, "elements.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "elements.0.type", resourceName, "elements.0.type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "elements.0.voice_analytics_processor_configuration.#", resourceName, "elements.0.voice_analytics_processor_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "resource_access_role_arn", resourceName, "resource_access_role_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+				),
+			},
+		},
+	})
+}
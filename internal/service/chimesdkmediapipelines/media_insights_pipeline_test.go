@@ -0,0 +1,144 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfchimesdkmediapipelines "github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChimeSDKMediaPipelinesMediaInsightsPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pipeline awstypes.MediaInsightsPipeline
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_insights_pipeline.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaInsightsPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaInsightsPipelineConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaInsightsPipelineExists(ctx, resourceName, &pipeline),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_video_stream_source_runtime_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_video_stream_source_runtime_configuration.0.media_encoding", string(awstypes.MediaEncodingPcm)),
+					resource.TestCheckResourceAttr(resourceName, "kinesis_video_stream_source_runtime_configuration.0.media_sample_rate", "8000"),
+					resource.TestCheckResourceAttrPair(resourceName, "kinesis_video_stream_source_runtime_configuration.0.streams.0.stream_arn", "aws_kinesis_video_stream.test", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "media_insights_pipeline_configuration_arn", "aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMediaInsightsPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chimesdkmediapipelines_media_insights_pipeline" {
+				continue
+			}
+
+			_, err := tfchimesdkmediapipelines.FindMediaInsightsPipelineByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingDestroyed,
+				tfchimesdkmediapipelines.ResNameMediaInsightsPipeline, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMediaInsightsPipelineExists(ctx context.Context, name string, v *awstypes.MediaInsightsPipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaInsightsPipeline, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+		resp, err := tfchimesdkmediapipelines.FindMediaInsightsPipelineByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaInsightsPipeline, rs.Primary.ID, err)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccMediaInsightsPipelineConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccMediaInsightsPipelineConfigurationConfig_basic(rName, rName, rName), fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_chimesdkmediapipelines_media_insights_pipeline" "test" {
+  media_insights_pipeline_configuration_arn = aws_chimesdkmediapipelines_media_insights_pipeline_configuration.test.arn
+
+  kinesis_video_stream_source_runtime_configuration {
+    media_encoding    = "pcm"
+    media_sample_rate = 8000
+
+    streams {
+      stream_arn = aws_kinesis_video_stream.test.arn
+
+      stream_channel_definition {
+        number_of_channels = 2
+
+        channel_definitions {
+          channel_id       = 0
+          participant_role = "AGENT"
+        }
+
+        channel_definitions {
+          channel_id       = 1
+          participant_role = "CUSTOMER"
+        }
+      }
+    }
+  }
+}
+`, rName))
+}
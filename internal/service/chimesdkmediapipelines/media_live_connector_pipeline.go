@@ -0,0 +1,412 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameMediaLiveConnectorPipeline = "Media Live Connector Pipeline"
+)
+
+// @SDKResource("aws_chimesdkmediapipelines_media_live_connector_pipeline", name="Media Live Connector Pipeline")
+// @Tags(identifierAttribute="arn")
+func resourceMediaLiveConnectorPipeline() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMediaLiveConnectorPipelineCreate,
+		ReadWithoutTimeout:   resourceMediaLiveConnectorPipelineRead,
+		UpdateWithoutTimeout: resourceMediaLiveConnectorPipelineUpdate,
+		DeleteWithoutTimeout: resourceMediaLiveConnectorPipelineDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(iamPropagationTimeout),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rtmp_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"audio_channels": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										Computed:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.AudioChannelsOption](),
+									},
+									"audio_sample_rate": {
+										Type:     schema.TypeString,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+									},
+									names.AttrURL: {
+										Type:      schema.TypeString,
+										Required:  true,
+										ForceNew:  true,
+										Sensitive: true,
+									},
+								},
+							},
+						},
+						"sink_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          awstypes.LiveConnectorSinkTypeRtmp,
+							ValidateDiagFunc: enum.Validate[awstypes.LiveConnectorSinkType](),
+						},
+					},
+				},
+			},
+			names.AttrSource: {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"chime_sdk_meeting_live_connector_configuration": {
+							Type:     schema.TypeList,
+							Required: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									names.AttrARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"composited_video": CompositedVideoArtifactsConfigurationSchema(),
+									"mux_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ForceNew:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.LiveConnectorMuxType](),
+									},
+									"source_configuration": SourceConfigurationSchema(),
+								},
+							},
+						},
+						"source_type": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							ForceNew:         true,
+							Default:          awstypes.LiveConnectorSourceTypeChimeSdkMeeting,
+							ValidateDiagFunc: enum.Validate[awstypes.LiveConnectorSourceType](),
+						},
+					},
+				},
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceMediaLiveConnectorPipelineCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	in := &chimesdkmediapipelines.CreateMediaLiveConnectorPipelineInput{
+		ClientRequestToken: aws.String(id.UniqueId()),
+		Sinks:              expandLiveConnectorSinkConfigurations(d.Get("sink").([]any)),
+		Sources:            expandLiveConnectorSourceConfigurations(d.Get(names.AttrSource).([]any)),
+		Tags:               getTagsIn(ctx),
+	}
+
+	// Retry when forbidden exception is received; iam role propagation is eventually consistent
+	var out *chimesdkmediapipelines.CreateMediaLiveConnectorPipelineOutput
+	createError := tfresource.Retry(ctx, d.Timeout(schema.TimeoutCreate), func() *retry.RetryError {
+		var err error
+		out, err = conn.CreateMediaLiveConnectorPipeline(ctx, in)
+		if err != nil {
+			var forbiddenException *awstypes.ForbiddenException
+			if errors.As(err, &forbiddenException) {
+				return retry.RetryableError(err)
+			}
+			return retry.NonRetryableError(err)
+		}
+
+		return nil
+	})
+	if createError != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaLiveConnectorPipeline, "", createError)
+	}
+
+	if out == nil || out.MediaLiveConnectorPipeline == nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaLiveConnectorPipeline, "", errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.MediaLiveConnectorPipeline.MediaPipelineId))
+
+	return append(diags, resourceMediaLiveConnectorPipelineRead(ctx, d, meta)...)
+}
+
+func resourceMediaLiveConnectorPipelineRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	out, err := findMediaLiveConnectorPipelineByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ChimeSDKMediaPipelines MediaLiveConnectorPipeline (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionReading, ResNameMediaLiveConnectorPipeline, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.MediaPipelineArn)
+	if err := d.Set("sink", flattenLiveConnectorSinkConfigurations(out.Sinks)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sink: %s", err)
+	}
+	if err := d.Set(names.AttrSource, flattenLiveConnectorSourceConfigurations(out.Sources)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting source: %s", err)
+	}
+	d.Set(names.AttrStatus, out.Status)
+
+	return diags
+}
+
+func resourceMediaLiveConnectorPipelineUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Tags only.
+	return resourceMediaLiveConnectorPipelineRead(ctx, d, meta)
+}
+
+func resourceMediaLiveConnectorPipelineDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	log.Printf("[INFO] Deleting ChimeSDKMediaPipelines MediaLiveConnectorPipeline %s", d.Id())
+	_, err := conn.DeleteMediaPipeline(ctx, &chimesdkmediapipelines.DeleteMediaPipelineInput{
+		MediaPipelineId: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionDeleting, ResNameMediaLiveConnectorPipeline, d.Id(), err)
+	}
+
+	if _, err := waitMediaLiveConnectorPipelineDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionWaitingForDeletion, ResNameMediaLiveConnectorPipeline, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMediaLiveConnectorPipelineByID(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) (*awstypes.MediaLiveConnectorPipeline, error) {
+	out, err := findMediaPipelineByID(ctx, conn, id)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out.MediaLiveConnectorPipeline == nil {
+		return nil, tfresource.NewEmptyResultError(id)
+	}
+
+	return out.MediaLiveConnectorPipeline, nil
+}
+
+func statusMediaLiveConnectorPipeline(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		out, err := findMediaLiveConnectorPipelineByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func waitMediaLiveConnectorPipelineDeleted(ctx context.Context, conn *chimesdkmediapipelines.Client, id string, timeout time.Duration) (*awstypes.MediaLiveConnectorPipeline, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.MediaPipelineStatusInitializing, awstypes.MediaPipelineStatusInProgress, awstypes.MediaPipelineStatusStopping, awstypes.MediaPipelineStatusStopped),
+		Target:  []string{},
+		Refresh: statusMediaLiveConnectorPipeline(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*awstypes.MediaLiveConnectorPipeline); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandLiveConnectorSinkConfigurations(tfList []any) []awstypes.LiveConnectorSinkConfiguration {
+	var apiObjects []awstypes.LiveConnectorSinkConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.LiveConnectorSinkConfiguration{
+			SinkType: awstypes.LiveConnectorSinkType(tfMap["sink_type"].(string)),
+		}
+
+		if v, ok := tfMap["rtmp_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]any)
+			apiObject.RTMPConfiguration = &awstypes.LiveConnectorRTMPConfiguration{
+				Url: aws.String(tfMap[names.AttrURL].(string)),
+			}
+
+			if v, ok := tfMap["audio_channels"].(string); ok && v != "" {
+				apiObject.RTMPConfiguration.AudioChannels = awstypes.AudioChannelsOption(v)
+			}
+
+			if v, ok := tfMap["audio_sample_rate"].(string); ok && v != "" {
+				apiObject.RTMPConfiguration.AudioSampleRate = aws.String(v)
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func expandLiveConnectorSourceConfigurations(tfList []any) []awstypes.LiveConnectorSourceConfiguration {
+	var apiObjects []awstypes.LiveConnectorSourceConfiguration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := awstypes.LiveConnectorSourceConfiguration{
+			SourceType: awstypes.LiveConnectorSourceType(tfMap["source_type"].(string)),
+		}
+
+		if v, ok := tfMap["chime_sdk_meeting_live_connector_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			tfMap := v[0].(map[string]any)
+			apiObject.ChimeSdkMeetingLiveConnectorConfiguration = &awstypes.ChimeSdkMeetingLiveConnectorConfiguration{
+				Arn:     aws.String(tfMap[names.AttrARN].(string)),
+				MuxType: awstypes.LiveConnectorMuxType(tfMap["mux_type"].(string)),
+			}
+
+			if v, ok := tfMap["composited_video"].([]any); ok && len(v) > 0 && v[0] != nil {
+				apiObject.ChimeSdkMeetingLiveConnectorConfiguration.CompositedVideo = expandCompositedVideoArtifactsConfiguration(v[0].(map[string]any))
+			}
+
+			if v, ok := tfMap["source_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+				apiObject.ChimeSdkMeetingLiveConnectorConfiguration.SourceConfiguration = expandSourceConfiguration(v[0].(map[string]any))
+			}
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenLiveConnectorSinkConfigurations(apiObjects []awstypes.LiveConnectorSinkConfiguration) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"sink_type": apiObject.SinkType,
+		}
+
+		if v := apiObject.RTMPConfiguration; v != nil {
+			tfMap["rtmp_configuration"] = []any{map[string]any{
+				"audio_channels":    v.AudioChannels,
+				"audio_sample_rate": aws.ToString(v.AudioSampleRate),
+				names.AttrURL:       aws.ToString(v.Url),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenLiveConnectorSourceConfigurations(apiObjects []awstypes.LiveConnectorSourceConfiguration) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"source_type": apiObject.SourceType,
+		}
+
+		if v := apiObject.ChimeSdkMeetingLiveConnectorConfiguration; v != nil {
+			tfMap["chime_sdk_meeting_live_connector_configuration"] = []any{map[string]any{
+				names.AttrARN:          aws.ToString(v.Arn),
+				"composited_video":     flattenCompositedVideoArtifactsConfiguration(v.CompositedVideo),
+				"mux_type":             v.MuxType,
+				"source_configuration": flattenSourceConfiguration(v.SourceConfiguration),
+			}}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
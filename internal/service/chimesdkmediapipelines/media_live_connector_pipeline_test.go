@@ -0,0 +1,130 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfchimesdkmediapipelines "github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Media live connector pipelines require an active Amazon Chime SDK meeting and an RTMP endpoint, neither of which can be managed by Terraform.
+func TestAccChimeSDKMediaPipelinesMediaLiveConnectorPipeline_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	meetingARN := acctest.SkipIfEnvVarNotSet(t, "AWS_CHIMESDK_MEETING_ARN")
+	rtmpURL := acctest.SkipIfEnvVarNotSet(t, "AWS_CHIMESDK_RTMP_URL")
+	var pipeline awstypes.MediaLiveConnectorPipeline
+	resourceName := "aws_chimesdkmediapipelines_media_live_connector_pipeline.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaLiveConnectorPipelineDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaLiveConnectorPipelineConfig_basic(meetingARN, rtmpURL),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaLiveConnectorPipelineExists(ctx, resourceName, &pipeline),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sink.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "sink.0.rtmp_configuration.0.url", rtmpURL),
+					resource.TestCheckResourceAttr(resourceName, "sink.0.sink_type", string(awstypes.LiveConnectorSinkTypeRtmp)),
+					resource.TestCheckResourceAttr(resourceName, "source.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "source.0.chime_sdk_meeting_live_connector_configuration.0.arn", meetingARN),
+					resource.TestCheckResourceAttr(resourceName, "source.0.chime_sdk_meeting_live_connector_configuration.0.mux_type", string(awstypes.LiveConnectorMuxTypeAudioWithActiveSpeakerVideo)),
+					resource.TestCheckResourceAttr(resourceName, "source.0.source_type", string(awstypes.LiveConnectorSourceTypeChimeSdkMeeting)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckMediaLiveConnectorPipelineDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chimesdkmediapipelines_media_live_connector_pipeline" {
+				continue
+			}
+
+			_, err := tfchimesdkmediapipelines.FindMediaLiveConnectorPipelineByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingDestroyed,
+				tfchimesdkmediapipelines.ResNameMediaLiveConnectorPipeline, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMediaLiveConnectorPipelineExists(ctx context.Context, name string, v *awstypes.MediaLiveConnectorPipeline) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaLiveConnectorPipeline, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+		resp, err := tfchimesdkmediapipelines.FindMediaLiveConnectorPipelineByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaLiveConnectorPipeline, rs.Primary.ID, err)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccMediaLiveConnectorPipelineConfig_basic(meetingARN, rtmpURL string) string {
+	return fmt.Sprintf(`
+resource "aws_chimesdkmediapipelines_media_live_connector_pipeline" "test" {
+  sink {
+    rtmp_configuration {
+      url = %[2]q
+    }
+  }
+
+  source {
+    chime_sdk_meeting_live_connector_configuration {
+      arn      = %[1]q
+      mux_type = "AudioWithActiveSpeakerVideo"
+    }
+  }
+}
+`, meetingARN, rtmpURL)
+}
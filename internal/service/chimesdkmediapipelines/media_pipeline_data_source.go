@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	DSNameMediaPipeline = "Media Pipeline Data Source"
+)
+
+// @SDKDataSource("aws_chimesdkmediapipelines_media_pipeline", name="Media Pipeline")
+func dataSourceMediaPipeline() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceMediaPipelineRead,
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"created_timestamp": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_insights_pipeline_configuration_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"media_pipeline_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"media_pipeline_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"sink_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"source_arn": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrStatus: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceMediaPipelineRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	id := d.Get("media_pipeline_id").(string)
+	out, err := findMediaPipelineByID(ctx, conn, id)
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionReading, DSNameMediaPipeline, id, err)
+	}
+
+	var (
+		arn, insightsConfigurationARN, pipelineType, sinkARN, sourceARN *string
+		createdTimestamp                                                *time.Time
+		status                                                          awstypes.MediaPipelineStatus
+	)
+	switch {
+	case out.MediaCapturePipeline != nil:
+		v := out.MediaCapturePipeline
+		arn, createdTimestamp, pipelineType, status = v.MediaPipelineArn, v.CreatedTimestamp, aws.String("MediaCapturePipeline"), v.Status
+		sinkARN, sourceARN = v.SinkArn, v.SourceArn
+	case out.MediaConcatenationPipeline != nil:
+		v := out.MediaConcatenationPipeline
+		arn, createdTimestamp, pipelineType, status = v.MediaPipelineArn, v.CreatedTimestamp, aws.String("MediaConcatenationPipeline"), v.Status
+	case out.MediaInsightsPipeline != nil:
+		v := out.MediaInsightsPipeline
+		arn, createdTimestamp, pipelineType, status = v.MediaPipelineArn, v.CreatedTimestamp, aws.String("MediaInsightsPipeline"), v.Status
+		insightsConfigurationARN = v.MediaInsightsPipelineConfigurationArn
+	case out.MediaLiveConnectorPipeline != nil:
+		v := out.MediaLiveConnectorPipeline
+		arn, createdTimestamp, pipelineType, status = v.MediaPipelineArn, v.CreatedTimestamp, aws.String("MediaLiveConnectorPipeline"), v.Status
+	case out.MediaStreamPipeline != nil:
+		v := out.MediaStreamPipeline
+		arn, createdTimestamp, pipelineType, status = v.MediaPipelineArn, v.CreatedTimestamp, aws.String("MediaStreamPipeline"), v.Status
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrARN, arn)
+	if createdTimestamp != nil {
+		d.Set("created_timestamp", createdTimestamp.Format(time.RFC3339))
+	} else {
+		d.Set("created_timestamp", nil)
+	}
+	d.Set("media_insights_pipeline_configuration_arn", insightsConfigurationARN)
+	d.Set("media_pipeline_type", pipelineType)
+	d.Set("sink_arn", sinkARN)
+	d.Set("source_arn", sourceARN)
+	d.Set(names.AttrStatus, status)
+
+	return diags
+}
+
+func findMediaPipelineByID(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) (*awstypes.MediaPipeline, error) {
+	in := &chimesdkmediapipelines.GetMediaPipelineInput{
+		MediaPipelineId: aws.String(id),
+	}
+	out, err := conn.GetMediaPipeline(ctx, in)
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.MediaPipeline == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.MediaPipeline, nil
+}
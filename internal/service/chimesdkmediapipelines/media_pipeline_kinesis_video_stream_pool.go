@@ -0,0 +1,317 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameMediaPipelineKinesisVideoStreamPool = "Media Pipeline Kinesis Video Stream Pool"
+)
+
+// @SDKResource("aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool", name="Media Pipeline Kinesis Video Stream Pool")
+// @Tags(identifierAttribute="arn")
+func resourceMediaPipelineKinesisVideoStreamPool() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceMediaPipelineKinesisVideoStreamPoolCreate,
+		ReadWithoutTimeout:   resourceMediaPipelineKinesisVideoStreamPoolRead,
+		UpdateWithoutTimeout: resourceMediaPipelineKinesisVideoStreamPoolUpdate,
+		DeleteWithoutTimeout: resourceMediaPipelineKinesisVideoStreamPoolDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"pool_name": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 128),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			"pool_size": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"pool_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"stream_configuration": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"data_retention_in_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntAtLeast(0),
+						},
+						names.AttrRegion: {
+							Type:     schema.TypeString,
+							Required: true,
+							ForceNew: true,
+						},
+					},
+				},
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+		},
+	}
+}
+
+func resourceMediaPipelineKinesisVideoStreamPoolCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	name := d.Get("pool_name").(string)
+	in := &chimesdkmediapipelines.CreateMediaPipelineKinesisVideoStreamPoolInput{
+		ClientRequestToken:  aws.String(id.UniqueId()),
+		PoolName:            aws.String(name),
+		StreamConfiguration: expandKinesisVideoStreamConfiguration(d.Get("stream_configuration").([]any)),
+		Tags:                getTagsIn(ctx),
+	}
+
+	out, err := conn.CreateMediaPipelineKinesisVideoStreamPool(ctx, in)
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaPipelineKinesisVideoStreamPool, name, err)
+	}
+
+	if out == nil || out.KinesisVideoStreamPoolConfiguration == nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionCreating, ResNameMediaPipelineKinesisVideoStreamPool, name, errors.New("empty output"))
+	}
+
+	d.SetId(aws.ToString(out.KinesisVideoStreamPoolConfiguration.PoolArn))
+
+	if _, err := waitMediaPipelineKinesisVideoStreamPoolActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionWaitingForCreation, ResNameMediaPipelineKinesisVideoStreamPool, d.Id(), err)
+	}
+
+	return append(diags, resourceMediaPipelineKinesisVideoStreamPoolRead(ctx, d, meta)...)
+}
+
+func resourceMediaPipelineKinesisVideoStreamPoolRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	out, err := findMediaPipelineKinesisVideoStreamPoolByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ChimeSDKMediaPipelines MediaPipelineKinesisVideoStreamPool (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionReading, ResNameMediaPipelineKinesisVideoStreamPool, d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, out.PoolArn)
+	d.Set("pool_id", out.PoolId)
+	d.Set("pool_name", out.PoolName)
+	d.Set("pool_size", out.PoolSize)
+	d.Set("pool_status", out.PoolStatus)
+	if err := d.Set("stream_configuration", flattenKinesisVideoStreamConfiguration(out.StreamConfiguration)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting stream_configuration: %s", err)
+	}
+
+	return diags
+}
+
+func resourceMediaPipelineKinesisVideoStreamPoolUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		in := &chimesdkmediapipelines.UpdateMediaPipelineKinesisVideoStreamPoolInput{
+			Identifier: aws.String(d.Id()),
+			StreamConfiguration: &awstypes.KinesisVideoStreamConfigurationUpdate{
+				DataRetentionInHours: aws.Int32(int32(d.Get("stream_configuration.0.data_retention_in_hours").(int))),
+			},
+		}
+
+		_, err := conn.UpdateMediaPipelineKinesisVideoStreamPool(ctx, in)
+		if err != nil {
+			return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionUpdating, ResNameMediaPipelineKinesisVideoStreamPool, d.Id(), err)
+		}
+
+		if _, err := waitMediaPipelineKinesisVideoStreamPoolActive(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionWaitingForUpdate, ResNameMediaPipelineKinesisVideoStreamPool, d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceMediaPipelineKinesisVideoStreamPoolRead(ctx, d, meta)...)
+}
+
+func resourceMediaPipelineKinesisVideoStreamPoolDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	conn := meta.(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+	log.Printf("[INFO] Deleting ChimeSDKMediaPipelines MediaPipelineKinesisVideoStreamPool %s", d.Id())
+	_, err := conn.DeleteMediaPipelineKinesisVideoStreamPool(ctx, &chimesdkmediapipelines.DeleteMediaPipelineKinesisVideoStreamPoolInput{
+		Identifier: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionDeleting, ResNameMediaPipelineKinesisVideoStreamPool, d.Id(), err)
+	}
+
+	if _, err := waitMediaPipelineKinesisVideoStreamPoolDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return create.AppendDiagError(diags, names.ChimeSDKMediaPipelines, create.ErrActionWaitingForDeletion, ResNameMediaPipelineKinesisVideoStreamPool, d.Id(), err)
+	}
+
+	return diags
+}
+
+func findMediaPipelineKinesisVideoStreamPoolByID(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) (*awstypes.KinesisVideoStreamPoolConfiguration, error) {
+	in := &chimesdkmediapipelines.GetMediaPipelineKinesisVideoStreamPoolInput{
+		Identifier: aws.String(id),
+	}
+	out, err := conn.GetMediaPipelineKinesisVideoStreamPool(ctx, in)
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: in,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.KinesisVideoStreamPoolConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out.KinesisVideoStreamPoolConfiguration, nil
+}
+
+func statusMediaPipelineKinesisVideoStreamPool(ctx context.Context, conn *chimesdkmediapipelines.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		out, err := findMediaPipelineKinesisVideoStreamPoolByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.PoolStatus), nil
+	}
+}
+
+func waitMediaPipelineKinesisVideoStreamPoolActive(ctx context.Context, conn *chimesdkmediapipelines.Client, id string, timeout time.Duration) (*awstypes.KinesisVideoStreamPoolConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.KinesisVideoStreamPoolStatusCreating, awstypes.KinesisVideoStreamPoolStatusUpdating),
+		Target:  enum.Slice(awstypes.KinesisVideoStreamPoolStatusActive),
+		Refresh: statusMediaPipelineKinesisVideoStreamPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*awstypes.KinesisVideoStreamPoolConfiguration); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitMediaPipelineKinesisVideoStreamPoolDeleted(ctx context.Context, conn *chimesdkmediapipelines.Client, id string, timeout time.Duration) (*awstypes.KinesisVideoStreamPoolConfiguration, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.KinesisVideoStreamPoolStatusActive, awstypes.KinesisVideoStreamPoolStatusDeleting),
+		Target:  []string{},
+		Refresh: statusMediaPipelineKinesisVideoStreamPool(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if out, ok := outputRaw.(*awstypes.KinesisVideoStreamPoolConfiguration); ok {
+		return out, err
+	}
+
+	return nil, err
+}
+
+func expandKinesisVideoStreamConfiguration(tfList []any) *awstypes.KinesisVideoStreamConfiguration {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &awstypes.KinesisVideoStreamConfiguration{
+		Region: aws.String(tfMap[names.AttrRegion].(string)),
+	}
+
+	if v, ok := tfMap["data_retention_in_hours"].(int); ok {
+		apiObject.DataRetentionInHours = aws.Int32(int32(v))
+	}
+
+	return apiObject
+}
+
+func flattenKinesisVideoStreamConfiguration(apiObject *awstypes.KinesisVideoStreamConfiguration) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"data_retention_in_hours": aws.ToInt32(apiObject.DataRetentionInHours),
+		names.AttrRegion:          aws.ToString(apiObject.Region),
+	}
+
+	return []any{tfMap}
+}
@@ -0,0 +1,248 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chimesdkmediapipelines_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfchimesdkmediapipelines "github.com/hashicorp/terraform-provider-aws/internal/service/chimesdkmediapipelines"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChimeSDKMediaPipelinesMediaPipelineKinesisVideoStreamPool_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pool awstypes.KinesisVideoStreamPoolConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaPipelineKinesisVideoStreamPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaPipelineKinesisVideoStreamPoolConfig_basic(rName, 24),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaPipelineKinesisVideoStreamPoolExists(ctx, resourceName, &pool),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "pool_id"),
+					resource.TestCheckResourceAttr(resourceName, "pool_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "pool_status", string(awstypes.KinesisVideoStreamPoolStatusActive)),
+					resource.TestCheckResourceAttr(resourceName, "stream_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "stream_configuration.0.data_retention_in_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "stream_configuration.0.region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaPipelineKinesisVideoStreamPoolConfig_basic(rName, 48),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckMediaPipelineKinesisVideoStreamPoolExists(ctx, resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, "stream_configuration.0.data_retention_in_hours", "48"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChimeSDKMediaPipelinesMediaPipelineKinesisVideoStreamPool_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pool awstypes.KinesisVideoStreamPoolConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaPipelineKinesisVideoStreamPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaPipelineKinesisVideoStreamPoolConfig_basic(rName, 24),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaPipelineKinesisVideoStreamPoolExists(ctx, resourceName, &pool),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfchimesdkmediapipelines.ResourceMediaPipelineKinesisVideoStreamPool(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChimeSDKMediaPipelinesMediaPipelineKinesisVideoStreamPool_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var pool awstypes.KinesisVideoStreamPoolConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckPartitionHasService(t, names.ChimeSDKMediaPipelinesEndpointID)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChimeSDKMediaPipelinesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckMediaPipelineKinesisVideoStreamPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMediaPipelineKinesisVideoStreamPoolConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaPipelineKinesisVideoStreamPoolExists(ctx, resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccMediaPipelineKinesisVideoStreamPoolConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaPipelineKinesisVideoStreamPoolExists(ctx, resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccMediaPipelineKinesisVideoStreamPoolConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckMediaPipelineKinesisVideoStreamPoolExists(ctx, resourceName, &pool),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckMediaPipelineKinesisVideoStreamPoolDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool" {
+				continue
+			}
+
+			_, err := tfchimesdkmediapipelines.FindMediaPipelineKinesisVideoStreamPoolByID(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingDestroyed,
+				tfchimesdkmediapipelines.ResNameMediaPipelineKinesisVideoStreamPool, rs.Primary.ID, errors.New("not destroyed"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckMediaPipelineKinesisVideoStreamPoolExists(ctx context.Context, name string, v *awstypes.KinesisVideoStreamPoolConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaPipelineKinesisVideoStreamPool, name, errors.New("not found"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChimeSDKMediaPipelinesClient(ctx)
+		resp, err := tfchimesdkmediapipelines.FindMediaPipelineKinesisVideoStreamPoolByID(ctx, conn, rs.Primary.ID)
+		if err != nil {
+			return create.Error(names.ChimeSDKMediaPipelines, create.ErrActionCheckingExistence,
+				tfchimesdkmediapipelines.ResNameMediaPipelineKinesisVideoStreamPool, rs.Primary.ID, err)
+		}
+
+		*v = *resp
+
+		return nil
+	}
+}
+
+func testAccMediaPipelineKinesisVideoStreamPoolConfig_basic(rName string, dataRetentionInHours int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool" "test" {
+  pool_name = %[1]q
+
+  stream_configuration {
+    data_retention_in_hours = %[2]d
+    region                  = data.aws_region.current.region
+  }
+}
+`, rName, dataRetentionInHours)
+}
+
+func testAccMediaPipelineKinesisVideoStreamPoolConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool" "test" {
+  pool_name = %[1]q
+
+  stream_configuration {
+    data_retention_in_hours = 24
+    region                  = data.aws_region.current.region
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccMediaPipelineKinesisVideoStreamPoolConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool" "test" {
+  pool_name = %[1]q
+
+  stream_configuration {
+    data_retention_in_hours = 24
+    region                  = data.aws_region.current.region
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
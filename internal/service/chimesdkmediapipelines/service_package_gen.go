@@ -27,11 +27,54 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
-	return []*inttypes.ServicePackageSDKDataSource{}
+	return []*inttypes.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceMediaInsightsPipelineConfiguration,
+			TypeName: "aws_chimesdkmediapipelines_media_insights_pipeline_configuration",
+			Name:     "Media Insights Pipeline Configuration",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  dataSourceMediaPipeline,
+			TypeName: "aws_chimesdkmediapipelines_media_pipeline",
+			Name:     "Media Pipeline",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
 	return []*inttypes.ServicePackageSDKResource{
+		{
+			Factory:  resourceMediaCapturePipeline,
+			TypeName: "aws_chimesdkmediapipelines_media_capture_pipeline",
+			Name:     "Media Capture Pipeline",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceMediaConcatenationPipeline,
+			TypeName: "aws_chimesdkmediapipelines_media_concatenation_pipeline",
+			Name:     "Media Concatenation Pipeline",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceMediaInsightsPipeline,
+			TypeName: "aws_chimesdkmediapipelines_media_insights_pipeline",
+			Name:     "Media Insights Pipeline",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  resourceMediaInsightsPipelineConfiguration,
 			TypeName: "aws_chimesdkmediapipelines_media_insights_pipeline_configuration",
@@ -48,6 +91,24 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 				WrappedImport: true,
 			},
 		},
+		{
+			Factory:  resourceMediaLiveConnectorPipeline,
+			TypeName: "aws_chimesdkmediapipelines_media_live_connector_pipeline",
+			Name:     "Media Live Connector Pipeline",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceMediaPipelineKinesisVideoStreamPool,
+			TypeName: "aws_chimesdkmediapipelines_media_pipeline_kinesis_video_stream_pool",
+			Name:     "Media Pipeline Kinesis Video Stream Pool",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
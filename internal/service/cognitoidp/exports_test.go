@@ -18,6 +18,7 @@ var (
 	ResourceUserPoolUICustomization = resourceUserPoolUICustomization
 
 	FindGroupByTwoPartKey                   = findGroupByTwoPartKey
+	FindGroupNamesByUserPoolID              = findGroupNamesByUserPoolID
 	FindGroupUserByThreePartKey             = findGroupUserByThreePartKey
 	FindIdentityProviderByTwoPartKey        = findIdentityProviderByTwoPartKey
 	FindResourceServerByTwoPartKey          = findResourceServerByTwoPartKey
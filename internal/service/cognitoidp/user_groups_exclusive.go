@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_cognito_user_groups_exclusive", name="User Groups Exclusive")
+func newUserGroupsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &userGroupsExclusiveResource{}, nil
+}
+
+const (
+	ResNameUserGroupsExclusive = "User Groups Exclusive"
+)
+
+type userGroupsExclusiveResource struct {
+	framework.ResourceWithModel[userGroupsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *userGroupsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"group_names": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			names.AttrUserPoolID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userGroupsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan userGroupsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groupNames []string
+	resp.Diagnostics.Append(plan.GroupNames.ElementsAs(ctx, &groupNames, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncGroups(ctx, plan.UserPoolID.ValueString(), groupNames)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CognitoIDP, create.ErrActionCreating, ResNameUserGroupsExclusive, plan.UserPoolID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *userGroupsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().CognitoIDPClient(ctx)
+
+	var state userGroupsExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findGroupNamesByUserPoolID(ctx, conn, state.UserPoolID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.CognitoIDP, create.ErrActionReading, ResNameUserGroupsExclusive, state.UserPoolID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.GroupNames = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *userGroupsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state userGroupsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.GroupNames.Equal(state.GroupNames) {
+		var groupNames []string
+		resp.Diagnostics.Append(plan.GroupNames.ElementsAs(ctx, &groupNames, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncGroups(ctx, plan.UserPoolID.ValueString(), groupNames)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.CognitoIDP, create.ErrActionUpdating, ResNameUserGroupsExclusive, plan.UserPoolID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncGroups handles keeping the configured user pool groups in sync with
+// the remote user pool.
+//
+// Groups in the user pool but not configured on this resource will be
+// deleted. Configured groups which do not exist cannot be created by this
+// resource and will return an error.
+func (r *userGroupsExclusiveResource) syncGroups(ctx context.Context, userPoolID string, want []string) error {
+	conn := r.Meta().CognitoIDPClient(ctx)
+
+	have, err := findGroupNamesByUserPoolID(ctx, conn, userPoolID)
+	if err != nil {
+		return err
+	}
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		return fmt.Errorf("groups not found: %v", missing)
+	}
+
+	for _, groupName := range remove {
+		input := cognitoidentityprovider.DeleteGroupInput{
+			GroupName:  aws.String(groupName),
+			UserPoolId: aws.String(userPoolID),
+		}
+
+		_, err := conn.DeleteGroup(ctx, &input)
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *userGroupsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrUserPoolID), req, resp)
+}
+
+func findGroupNamesByUserPoolID(ctx context.Context, conn *cognitoidentityprovider.Client, userPoolID string) ([]string, error) {
+	groups, err := findGroupsByUserPoolID(ctx, conn, userPoolID)
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(groups, func(v awstypes.GroupType) string {
+		return aws.ToString(v.GroupName)
+	}), nil
+}
+
+type userGroupsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	GroupNames fwtypes.SetOfString `tfsdk:"group_names"`
+	UserPoolID types.String        `tfsdk:"user_pool_id"`
+}
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitoidp_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cognitoidentityprovider"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfcognitoidp "github.com/hashicorp/terraform-provider-aws/internal/service/cognitoidp"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccCognitoIDPUserGroupsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_user_groups_exclusive.test"
+	userPoolResourceName := "aws_cognito_user_pool.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserGroupsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrUserPoolID, userPoolResourceName, names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "group_names.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "group_names.*", "aws_cognito_user_group.test", names.AttrName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrUserPoolID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrUserPoolID,
+			},
+		},
+	})
+}
+
+func TestAccCognitoIDPUserGroupsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_cognito_user_groups_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheckIdentityProvider(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.CognitoIDPServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckUserPoolDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserGroupsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserGroupsExclusiveExists(ctx, resourceName),
+					testAccCheckUserPoolCreateGroup(ctx, "aws_cognito_user_pool.test", rName+"-out-of-band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccUserGroupsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserGroupsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "group_names.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserGroupsExclusiveExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.CognitoIDP, create.ErrActionCheckingExistence, tfcognitoidp.ResNameUserGroupsExclusive, name, errors.New("not found"))
+		}
+
+		userPoolID := rs.Primary.Attributes[names.AttrUserPoolID]
+		if userPoolID == "" {
+			return create.Error(names.CognitoIDP, create.ErrActionCheckingExistence, tfcognitoidp.ResNameUserGroupsExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPClient(ctx)
+		out, err := tfcognitoidp.FindGroupNamesByUserPoolID(ctx, conn, userPoolID)
+		if err != nil {
+			return create.Error(names.CognitoIDP, create.ErrActionCheckingExistence, tfcognitoidp.ResNameUserGroupsExclusive, userPoolID, err)
+		}
+
+		groupCount := rs.Primary.Attributes["group_names.#"]
+		if groupCount != strconv.Itoa(len(out)) {
+			return create.Error(names.CognitoIDP, create.ErrActionCheckingExistence, tfcognitoidp.ResNameUserGroupsExclusive, userPoolID, errors.New("unexpected group_names count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckUserPoolCreateGroup(ctx context.Context, userPoolName, groupName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[userPoolName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", userPoolName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).CognitoIDPClient(ctx)
+
+		input := cognitoidentityprovider.CreateGroupInput{
+			GroupName:  aws.String(groupName),
+			UserPoolId: aws.String(rs.Primary.ID),
+		}
+
+		_, err := conn.CreateGroup(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccUserGroupsExclusiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_cognito_user_pool" "test" {
+  name = %[1]q
+}
+
+resource "aws_cognito_user_group" "test" {
+  name         = %[1]q
+  user_pool_id = aws_cognito_user_pool.test.id
+}
+
+resource "aws_cognito_user_groups_exclusive" "test" {
+  user_pool_id = aws_cognito_user_pool.test.id
+  group_names  = [aws_cognito_user_group.test.name]
+}
+`, rName)
+}
@@ -0,0 +1,556 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_dataset", name="Dataset")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeDatasetOutput")
+func newDatasetResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &datasetResource{}
+
+	return r, nil
+}
+
+type datasetResource struct {
+	framework.ResourceWithModel[datasetResourceModel]
+}
+
+func (r *datasetResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InputFormat](),
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.Source](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"format_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[formatOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"csv": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[csvOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"delimiter": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 1),
+										},
+									},
+									"header_row": schema.BoolAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+						"excel": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[excelOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"header_row": schema.BoolAttribute{
+										Optional: true,
+									},
+									"sheet_names": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						names.AttrJSON: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[jsonOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"multi_line": schema.BoolAttribute{
+										Optional: true,
+										Computed: true,
+										Default:  booldefault.StaticBool(false),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"input": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"data_catalog_input_definition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dataCatalogInputDefinitionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCatalogID: schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"temp_directory": s3LocationBlock(ctx),
+								},
+							},
+						},
+						"database_input_definition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[databaseInputDefinitionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"database_table_name": schema.StringAttribute{
+										Optional: true,
+									},
+									"glue_connection_name": schema.StringAttribute{
+										Required: true,
+									},
+									"query_string": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"temp_directory": s3LocationBlock(ctx),
+								},
+							},
+						},
+						"metadata": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[metadataModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"source_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"s3_input_definition": s3LocationBlock(ctx),
+					},
+				},
+			},
+			"path_options": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[pathOptionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"files_limit": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[filesLimitModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"max_files": schema.Int64Attribute{
+										Required: true,
+									},
+									"order": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.Order](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"ordered_by": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.OrderedBy](),
+										Optional:   true,
+										Computed:   true,
+									},
+								},
+							},
+						},
+						"last_modified_date_condition": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[filterExpressionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrExpression: schema.StringAttribute{
+										Required: true,
+									},
+									"values_map": schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Required:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// s3LocationBlock returns the schema for an S3 location, as used by datasets and jobs.
+func s3LocationBlock(ctx context.Context) schema.ListNestedBlock {
+	return schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[s3LocationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrBucket: schema.StringAttribute{
+					Required: true,
+				},
+				"bucket_owner": schema.StringAttribute{
+					Optional: true,
+				},
+				names.AttrKey: schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+}
+
+// resourceFlexOpt maps the "ResourceArn" field returned by the Describe APIs to the "ARN" model field.
+var resourceFlexOpt = fwflex.WithFieldNamePrefix("Resource")
+
+func (r *datasetResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data datasetResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateDatasetInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateDataset(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Dataset (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findDatasetByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Dataset (%s)", name), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, resourceFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *datasetResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data datasetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findDatasetByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Dataset (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, resourceFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *datasetResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new datasetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := new.Name.ValueString()
+		var input databrew.UpdateDatasetInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateDataset(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Dataset (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := findDatasetByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Dataset (%s)", name), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new, resourceFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *datasetResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data datasetResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	tflog.Debug(ctx, "deleting DataBrew Dataset", map[string]any{
+		names.AttrName: name,
+	})
+	input := databrew.DeleteDatasetInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteDataset(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Dataset (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *datasetResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+func findDatasetByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeDatasetOutput, error) {
+	input := databrew.DescribeDatasetInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.DescribeDataset(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type datasetResourceModel struct {
+	framework.WithRegionModel
+	ARN           types.String                                        `tfsdk:"arn"`
+	Format        fwtypes.StringEnum[awstypes.InputFormat]            `tfsdk:"format"`
+	FormatOptions fwtypes.ListNestedObjectValueOf[formatOptionsModel] `tfsdk:"format_options"`
+	Input         fwtypes.ListNestedObjectValueOf[inputModel]         `tfsdk:"input"`
+	Name          types.String                                        `tfsdk:"name"`
+	PathOptions   fwtypes.ListNestedObjectValueOf[pathOptionsModel]   `tfsdk:"path_options"`
+	Source        fwtypes.StringEnum[awstypes.Source]                 `tfsdk:"source"`
+	Tags          tftags.Map                                          `tfsdk:"tags"`
+	TagsAll       tftags.Map                                          `tfsdk:"tags_all"`
+}
+
+type formatOptionsModel struct {
+	CSV   fwtypes.ListNestedObjectValueOf[csvOptionsModel]   `tfsdk:"csv"`
+	Excel fwtypes.ListNestedObjectValueOf[excelOptionsModel] `tfsdk:"excel"`
+	JSON  fwtypes.ListNestedObjectValueOf[jsonOptionsModel]  `tfsdk:"json"`
+}
+
+type csvOptionsModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+	HeaderRow types.Bool   `tfsdk:"header_row"`
+}
+
+type excelOptionsModel struct {
+	HeaderRow  types.Bool           `tfsdk:"header_row"`
+	SheetNames fwtypes.ListOfString `tfsdk:"sheet_names"`
+}
+
+type jsonOptionsModel struct {
+	MultiLine types.Bool `tfsdk:"multi_line"`
+}
+
+type inputModel struct {
+	DataCatalogInputDefinition fwtypes.ListNestedObjectValueOf[dataCatalogInputDefinitionModel] `tfsdk:"data_catalog_input_definition"`
+	DatabaseInputDefinition    fwtypes.ListNestedObjectValueOf[databaseInputDefinitionModel]    `tfsdk:"database_input_definition"`
+	Metadata                   fwtypes.ListNestedObjectValueOf[metadataModel]                   `tfsdk:"metadata"`
+	S3InputDefinition          fwtypes.ListNestedObjectValueOf[s3LocationModel]                 `tfsdk:"s3_input_definition"`
+}
+
+type dataCatalogInputDefinitionModel struct {
+	CatalogID     types.String                                     `tfsdk:"catalog_id"`
+	DatabaseName  types.String                                     `tfsdk:"database_name"`
+	TableName     types.String                                     `tfsdk:"table_name"`
+	TempDirectory fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type databaseInputDefinitionModel struct {
+	DatabaseTableName  types.String                                     `tfsdk:"database_table_name"`
+	GlueConnectionName types.String                                     `tfsdk:"glue_connection_name"`
+	QueryString        types.String                                     `tfsdk:"query_string"`
+	TempDirectory      fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type metadataModel struct {
+	SourceARN fwtypes.ARN `tfsdk:"source_arn"`
+}
+
+type s3LocationModel struct {
+	Bucket      types.String `tfsdk:"bucket"`
+	BucketOwner types.String `tfsdk:"bucket_owner"`
+	Key         types.String `tfsdk:"key"`
+}
+
+type pathOptionsModel struct {
+	FilesLimit                fwtypes.ListNestedObjectValueOf[filesLimitModel]       `tfsdk:"files_limit"`
+	LastModifiedDateCondition fwtypes.ListNestedObjectValueOf[filterExpressionModel] `tfsdk:"last_modified_date_condition"`
+}
+
+type filesLimitModel struct {
+	MaxFiles  types.Int64                            `tfsdk:"max_files"`
+	Order     fwtypes.StringEnum[awstypes.Order]     `tfsdk:"order"`
+	OrderedBy fwtypes.StringEnum[awstypes.OrderedBy] `tfsdk:"ordered_by"`
+}
+
+type filterExpressionModel struct {
+	Expression types.String        `tfsdk:"expression"`
+	ValuesMap  fwtypes.MapOfString `tfsdk:"values_map"`
+}
@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+// Exports for use in tests only.
+var (
+	ResourceDataset  = newDatasetResource
+	ResourceJob      = newJobResource
+	ResourceProject  = newProjectResource
+	ResourceRecipe   = newRecipeResource
+	ResourceRuleset  = newRulesetResource
+	ResourceSchedule = newScheduleResource
+
+	FindDatasetByName          = findDatasetByName
+	FindJobByName              = findJobByName
+	FindProjectByName          = findProjectByName
+	FindRecipeByTwoPartKey     = findRecipeByTwoPartKey
+	FindRulesetByName          = findRulesetByName
+	FindScheduleByName         = findScheduleByName
+	RecipeVersionLatestWorking = recipeVersionLatestWorking
+)
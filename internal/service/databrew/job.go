@@ -0,0 +1,653 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_databrew_job", name="Job")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeJobOutput")
+func newJobResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &jobResource{}
+
+	return r, nil
+}
+
+type jobResource struct {
+	framework.ResourceWithModel[jobResourceModel]
+}
+
+func (r *jobResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	requiredS3LocationBlock := s3LocationBlock(ctx)
+	requiredS3LocationBlock.Validators = append(requiredS3LocationBlock.Validators, listvalidator.IsRequired())
+
+	databaseTableOutputOptionsBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[databaseTableOutputOptionsModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrTableName: schema.StringAttribute{
+					Required: true,
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"temp_directory": s3LocationBlock(ctx),
+			},
+		},
+	}
+
+	requiredDatabaseTableOutputOptionsBlock := databaseTableOutputOptionsBlock
+	requiredDatabaseTableOutputOptionsBlock.Validators = append(requiredDatabaseTableOutputOptionsBlock.Validators, listvalidator.IsRequired())
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"dataset_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"encryption_mode": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EncryptionMode](),
+				Optional:   true,
+			},
+			"job_sample": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[jobSampleModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[jobSampleModel](ctx),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+			},
+			"log_subscription": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LogSubscription](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrMaxCapacity: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 240),
+				},
+			},
+			"project_name": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrTimeout: schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.JobType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"data_catalog_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dataCatalogOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrCatalogID: schema.StringAttribute{
+							Optional: true,
+						},
+						names.AttrDatabaseName: schema.StringAttribute{
+							Required: true,
+						},
+						"overwrite": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						names.AttrTableName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": databaseTableOutputOptionsBlock,
+						"s3_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3TableOutputOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									names.AttrLocation: requiredS3LocationBlock,
+								},
+							},
+						},
+					},
+				},
+			},
+			"database_output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[databaseOutputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"database_output_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DatabaseOutputMode](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.DatabaseOutputModeNewTable)),
+						},
+						"glue_connection_name": schema.StringAttribute{
+							Required: true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"database_options": requiredDatabaseTableOutputOptionsBlock,
+					},
+				},
+			},
+			"output": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"compression_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.CompressionFormat](),
+							Optional:   true,
+						},
+						names.AttrFormat: schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.OutputFormat](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"max_output_files": schema.Int64Attribute{
+							Optional: true,
+							Validators: []validator.Int64{
+								int64validator.Between(1, 999),
+							},
+						},
+						"overwrite": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"partition_columns": schema.ListAttribute{
+							CustomType:  fwtypes.ListOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"format_options": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[outputFormatOptionsModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Blocks: map[string]schema.Block{
+									"csv": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[csvOutputOptionsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"delimiter": schema.StringAttribute{
+													Optional: true,
+													Validators: []validator.String{
+														stringvalidator.LengthBetween(1, 1),
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						names.AttrLocation: requiredS3LocationBlock,
+					},
+				},
+			},
+			"output_location": s3LocationBlock(ctx),
+			"recipe_reference": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeReferenceModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						"recipe_version": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"validation_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[validationConfigurationModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"ruleset_arn": schema.StringAttribute{
+							CustomType: fwtypes.ARNType,
+							Required:   true,
+						},
+						"validation_mode": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.ValidationMode](),
+							Optional:   true,
+							Computed:   true,
+							Default:    stringdefault.StaticString(string(awstypes.ValidationModeCheckAll)),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *jobResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data jobResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var err error
+	switch data.Type.ValueEnum() {
+	case awstypes.JobTypeProfile:
+		var input databrew.CreateProfileJobInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Tags = getTagsIn(ctx)
+
+		_, err = conn.CreateProfileJob(ctx, &input)
+	case awstypes.JobTypeRecipe:
+		var input databrew.CreateRecipeJobInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.Tags = getTagsIn(ctx)
+
+		_, err = conn.CreateRecipeJob(ctx, &input)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Job (%s)", name), err.Error())
+
+		return
+	}
+
+	output, err := findJobByName(ctx, conn, name)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Job (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenJob(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *jobResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data jobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findJobByName(ctx, conn, name)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Job (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenJob(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *jobResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new jobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		name := new.Name.ValueString()
+		var err error
+		switch new.Type.ValueEnum() {
+		case awstypes.JobTypeProfile:
+			var input databrew.UpdateProfileJobInput
+			response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			_, err = conn.UpdateProfileJob(ctx, &input)
+		case awstypes.JobTypeRecipe:
+			var input databrew.UpdateRecipeJobInput
+			response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			_, err = conn.UpdateRecipeJob(ctx, &input)
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Job (%s)", name), err.Error())
+
+			return
+		}
+
+		output, err := findJobByName(ctx, conn, name)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Job (%s)", name), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(flattenJob(ctx, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *jobResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data jobResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	tflog.Debug(ctx, "deleting DataBrew Job", map[string]any{
+		names.AttrName: name,
+	})
+	input := databrew.DeleteJobInput{
+		Name: aws.String(name),
+	}
+	_, err := conn.DeleteJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Job (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *jobResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+// flattenJob flattens a job description into the resource model.
+// Profile jobs report their output location as the single element of Outputs.
+func flattenJob(ctx context.Context, apiObject *databrew.DescribeJobOutput, data *jobResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, data, resourceFlexOpt)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if apiObject.Type != awstypes.JobTypeProfile {
+		return diags
+	}
+
+	data.Outputs = fwtypes.NewListNestedObjectValueOfNull[outputModel](ctx)
+	data.OutputLocation = fwtypes.NewListNestedObjectValueOfNull[s3LocationModel](ctx)
+
+	if len(apiObject.Outputs) > 0 && apiObject.Outputs[0].Location != nil {
+		location := apiObject.Outputs[0].Location
+		data.OutputLocation = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &s3LocationModel{
+			Bucket:      fwflex.StringToFramework(ctx, location.Bucket),
+			BucketOwner: fwflex.StringToFramework(ctx, location.BucketOwner),
+			Key:         fwflex.StringToFramework(ctx, location.Key),
+		})
+	}
+
+	return diags
+}
+
+func findJobByName(ctx context.Context, conn *databrew.Client, name string) (*databrew.DescribeJobOutput, error) {
+	input := databrew.DescribeJobInput{
+		Name: aws.String(name),
+	}
+	output, err := conn.DescribeJob(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type jobResourceModel struct {
+	framework.WithRegionModel
+	ARN                      types.String                                                  `tfsdk:"arn"`
+	DataCatalogOutputs       fwtypes.ListNestedObjectValueOf[dataCatalogOutputModel]       `tfsdk:"data_catalog_output"`
+	DatabaseOutputs          fwtypes.ListNestedObjectValueOf[databaseOutputModel]          `tfsdk:"database_output"`
+	DatasetName              types.String                                                  `tfsdk:"dataset_name"`
+	EncryptionKeyARN         fwtypes.ARN                                                   `tfsdk:"encryption_key_arn"`
+	EncryptionMode           fwtypes.StringEnum[awstypes.EncryptionMode]                   `tfsdk:"encryption_mode"`
+	JobSample                fwtypes.ListNestedObjectValueOf[jobSampleModel]               `tfsdk:"job_sample"`
+	LogSubscription          fwtypes.StringEnum[awstypes.LogSubscription]                  `tfsdk:"log_subscription"`
+	MaxCapacity              types.Int64                                                   `tfsdk:"max_capacity"`
+	MaxRetries               types.Int64                                                   `tfsdk:"max_retries"`
+	Name                     types.String                                                  `tfsdk:"name"`
+	OutputLocation           fwtypes.ListNestedObjectValueOf[s3LocationModel]              `tfsdk:"output_location"`
+	Outputs                  fwtypes.ListNestedObjectValueOf[outputModel]                  `tfsdk:"output"`
+	ProjectName              types.String                                                  `tfsdk:"project_name"`
+	RecipeReference          fwtypes.ListNestedObjectValueOf[recipeReferenceModel]         `tfsdk:"recipe_reference"`
+	RoleARN                  fwtypes.ARN                                                   `tfsdk:"role_arn"`
+	Tags                     tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                  tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeout                  types.Int64                                                   `tfsdk:"timeout"`
+	Type                     fwtypes.StringEnum[awstypes.JobType]                          `tfsdk:"type"`
+	ValidationConfigurations fwtypes.ListNestedObjectValueOf[validationConfigurationModel] `tfsdk:"validation_configuration"`
+}
+
+type dataCatalogOutputModel struct {
+	CatalogID       types.String                                                     `tfsdk:"catalog_id"`
+	DatabaseName    types.String                                                     `tfsdk:"database_name"`
+	DatabaseOptions fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	Overwrite       types.Bool                                                       `tfsdk:"overwrite"`
+	S3Options       fwtypes.ListNestedObjectValueOf[s3TableOutputOptionsModel]       `tfsdk:"s3_options"`
+	TableName       types.String                                                     `tfsdk:"table_name"`
+}
+
+type databaseTableOutputOptionsModel struct {
+	TableName     types.String                                     `tfsdk:"table_name"`
+	TempDirectory fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"temp_directory"`
+}
+
+type s3TableOutputOptionsModel struct {
+	Location fwtypes.ListNestedObjectValueOf[s3LocationModel] `tfsdk:"location"`
+}
+
+type databaseOutputModel struct {
+	DatabaseOptions    fwtypes.ListNestedObjectValueOf[databaseTableOutputOptionsModel] `tfsdk:"database_options"`
+	DatabaseOutputMode fwtypes.StringEnum[awstypes.DatabaseOutputMode]                  `tfsdk:"database_output_mode"`
+	GlueConnectionName types.String                                                     `tfsdk:"glue_connection_name"`
+}
+
+type outputModel struct {
+	CompressionFormat fwtypes.StringEnum[awstypes.CompressionFormat]            `tfsdk:"compression_format"`
+	Format            fwtypes.StringEnum[awstypes.OutputFormat]                 `tfsdk:"format"`
+	FormatOptions     fwtypes.ListNestedObjectValueOf[outputFormatOptionsModel] `tfsdk:"format_options"`
+	Location          fwtypes.ListNestedObjectValueOf[s3LocationModel]          `tfsdk:"location"`
+	MaxOutputFiles    types.Int64                                               `tfsdk:"max_output_files"`
+	Overwrite         types.Bool                                                `tfsdk:"overwrite"`
+	PartitionColumns  fwtypes.ListOfString                                      `tfsdk:"partition_columns"`
+}
+
+type outputFormatOptionsModel struct {
+	CSV fwtypes.ListNestedObjectValueOf[csvOutputOptionsModel] `tfsdk:"csv"`
+}
+
+type csvOutputOptionsModel struct {
+	Delimiter types.String `tfsdk:"delimiter"`
+}
+
+type jobSampleModel struct {
+	Mode fwtypes.StringEnum[awstypes.SampleMode] `tfsdk:"mode"`
+	Size types.Int64                             `tfsdk:"size"`
+}
+
+type recipeReferenceModel struct {
+	Name          types.String `tfsdk:"name"`
+	RecipeVersion types.String `tfsdk:"recipe_version"`
+}
+
+type validationConfigurationModel struct {
+	RulesetARN     fwtypes.ARN                                 `tfsdk:"ruleset_arn"`
+	ValidationMode fwtypes.StringEnum[awstypes.ValidationMode] `tfsdk:"validation_mode"`
+}
@@ -0,0 +1,492 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/databrew/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	recipeVersionLatestWorking = "LATEST_WORKING"
+
+	// BatchDeleteRecipeVersion accepts at most 50 versions per call.
+	recipeVersionBatchDeleteLimit = 50
+)
+
+// @FrameworkResource("aws_databrew_recipe", name="Recipe")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/databrew;databrew.DescribeRecipeOutput")
+func newRecipeResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &recipeResource{}
+
+	return r, nil
+}
+
+type recipeResource struct {
+	framework.ResourceWithModel[recipeResourceModel]
+}
+
+func (r *recipeResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(1024),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 255),
+				},
+			},
+			"publish": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"published_version": schema.StringAttribute{
+				Computed: true,
+			},
+			"recipe_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"step": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[recipeStepModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						names.AttrAction: schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[recipeActionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"operation": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrParameters: schema.MapAttribute{
+										CustomType:  fwtypes.MapOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+								},
+							},
+						},
+						"condition_expression": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[conditionExpressionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrCondition: schema.StringAttribute{
+										Required: true,
+									},
+									"target_column": schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrValue: schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *recipeResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	var input databrew.CreateRecipeInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	_, err := conn.CreateRecipe(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	if data.Publish.ValueBool() {
+		if err := publishRecipe(ctx, conn, name, data.Description.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(r.refresh(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *recipeResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, resourceFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	publishedVersion, err := findLatestPublishedRecipeVersion(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		data.PublishedVersion = types.StringNull()
+	case err != nil:
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published versions", name), err.Error())
+
+		return
+	default:
+		data.PublishedVersion = fwflex.StringToFramework(ctx, publishedVersion.RecipeVersion)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *recipeResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := new.Name.ValueString()
+	changed := !new.Description.Equal(old.Description) || !new.Steps.Equal(old.Steps)
+
+	if changed {
+		var input databrew.UpdateRecipeInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateRecipe(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	// Publish a new version whenever the working version changes, or when publishing is first enabled.
+	if new.Publish.ValueBool() && (changed || !old.Publish.ValueBool()) {
+		if err := publishRecipe(ctx, conn, name, new.Description.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("publishing DataBrew Recipe (%s)", name), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(r.refresh(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *recipeResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data recipeResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().DataBrewClient(ctx)
+
+	name := data.Name.ValueString()
+
+	// All published versions must be deleted before the working version.
+	versions, err := findPublishedRecipeVersions(ctx, conn, name)
+
+	if err != nil && !tfresource.NotFound(err) {
+		response.Diagnostics.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published versions", name), err.Error())
+
+		return
+	}
+
+	for chunk := range slices.Chunk(tfslices.ApplyToAll(versions, func(v awstypes.Recipe) string {
+		return aws.ToString(v.RecipeVersion)
+	}), recipeVersionBatchDeleteLimit) {
+		input := databrew.BatchDeleteRecipeVersionInput{
+			Name:           aws.String(name),
+			RecipeVersions: chunk,
+		}
+		output, err := conn.BatchDeleteRecipeVersion(ctx, &input)
+
+		if err == nil && output != nil && len(output.Errors) > 0 {
+			v := output.Errors[0]
+			err = fmt.Errorf("%s: %s: %s", aws.ToString(v.RecipeVersion), aws.ToString(v.ErrorCode), aws.ToString(v.ErrorMessage))
+		}
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s) published versions", name), err.Error())
+
+			return
+		}
+	}
+
+	tflog.Debug(ctx, "deleting DataBrew Recipe", map[string]any{
+		names.AttrName: name,
+	})
+	input := databrew.DeleteRecipeVersionInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(recipeVersionLatestWorking),
+	}
+	_, err = conn.DeleteRecipeVersion(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting DataBrew Recipe (%s)", name), err.Error())
+
+		return
+	}
+}
+
+func (r *recipeResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrName), request, response)
+}
+
+// refresh sets the computed version attributes from the current state of the recipe.
+func (r *recipeResource) refresh(ctx context.Context, conn *databrew.Client, data *recipeResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	name := data.Name.ValueString()
+
+	output, err := findRecipeByTwoPartKey(ctx, conn, name, recipeVersionLatestWorking)
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading DataBrew Recipe (%s)", name), err.Error())
+
+		return diags
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.ResourceArn)
+	data.RecipeVersion = fwflex.StringToFramework(ctx, output.RecipeVersion)
+
+	publishedVersion, err := findLatestPublishedRecipeVersion(ctx, conn, name)
+
+	switch {
+	case tfresource.NotFound(err):
+		data.PublishedVersion = types.StringNull()
+	case err != nil:
+		diags.AddError(fmt.Sprintf("reading DataBrew Recipe (%s) published versions", name), err.Error())
+	default:
+		data.PublishedVersion = fwflex.StringToFramework(ctx, publishedVersion.RecipeVersion)
+	}
+
+	return diags
+}
+
+func publishRecipe(ctx context.Context, conn *databrew.Client, name, description string) error {
+	input := databrew.PublishRecipeInput{
+		Name: aws.String(name),
+	}
+	if description != "" {
+		input.Description = aws.String(description)
+	}
+
+	_, err := conn.PublishRecipe(ctx, &input)
+
+	return err
+}
+
+func findRecipeByTwoPartKey(ctx context.Context, conn *databrew.Client, name, version string) (*databrew.DescribeRecipeOutput, error) {
+	input := databrew.DescribeRecipeInput{
+		Name:          aws.String(name),
+		RecipeVersion: aws.String(version),
+	}
+	output, err := conn.DescribeRecipe(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findLatestPublishedRecipeVersion(ctx context.Context, conn *databrew.Client, name string) (*awstypes.Recipe, error) {
+	output, err := findPublishedRecipeVersions(ctx, conn, name)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(name)
+	}
+
+	latest := slices.MaxFunc(output, func(a, b awstypes.Recipe) int {
+		return aws.ToTime(a.PublishedDate).Compare(aws.ToTime(b.PublishedDate))
+	})
+
+	return &latest, nil
+}
+
+func findPublishedRecipeVersions(ctx context.Context, conn *databrew.Client, name string) ([]awstypes.Recipe, error) {
+	input := databrew.ListRecipeVersionsInput{
+		Name: aws.String(name),
+	}
+	var output []awstypes.Recipe
+
+	pages := databrew.NewListRecipeVersionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Recipes {
+			if aws.ToString(v.RecipeVersion) != recipeVersionLatestWorking && v.PublishedDate != nil {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type recipeResourceModel struct {
+	framework.WithRegionModel
+	ARN              types.String                                     `tfsdk:"arn"`
+	Description      types.String                                     `tfsdk:"description"`
+	Name             types.String                                     `tfsdk:"name"`
+	Publish          types.Bool                                       `tfsdk:"publish" autoflex:"-"`
+	PublishedVersion types.String                                     `tfsdk:"published_version" autoflex:"-"`
+	RecipeVersion    types.String                                     `tfsdk:"recipe_version"`
+	Steps            fwtypes.ListNestedObjectValueOf[recipeStepModel] `tfsdk:"step"`
+	Tags             tftags.Map                                       `tfsdk:"tags"`
+	TagsAll          tftags.Map                                       `tfsdk:"tags_all"`
+}
+
+type recipeStepModel struct {
+	Action               fwtypes.ListNestedObjectValueOf[recipeActionModel]        `tfsdk:"action"`
+	ConditionExpressions fwtypes.ListNestedObjectValueOf[conditionExpressionModel] `tfsdk:"condition_expression"`
+}
+
+type recipeActionModel struct {
+	Operation  types.String        `tfsdk:"operation"`
+	Parameters fwtypes.MapOfString `tfsdk:"parameters"`
+}
+
+type conditionExpressionModel struct {
+	Condition    types.String `tfsdk:"condition"`
+	TargetColumn types.String `tfsdk:"target_column"`
+	Value        types.String `tfsdk:"value"`
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRecipe_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+	var v databrew.DescribeRecipeOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName, "UPPER_CASE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "databrew", "recipe/{name}"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "publish", acctest.CtFalse),
+					resource.TestCheckNoResourceAttr(resourceName, "published_version"),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "0.1"),
+					resource.TestCheckResourceAttr(resourceName, "step.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.operation", "UPPER_CASE"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.parameters.sourceColumn", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportStateVerifyIgnore:              []string{"publish"},
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+	var v databrew.DescribeRecipeOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_basic(rName, "UPPER_CASE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRecipe, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRecipe_publish(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_recipe.test"
+	var v databrew.DescribeRecipeOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecipeDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecipeConfig_publish(rName, "UPPER_CASE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "publish", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "published_version", "1.0"),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "1.1"),
+				),
+			},
+			{
+				Config: testAccRecipeConfig_publish(rName, "LOWER_CASE"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRecipeExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "published_version", "2.0"),
+					resource.TestCheckResourceAttr(resourceName, "recipe_version", "2.1"),
+					resource.TestCheckResourceAttr(resourceName, "step.0.action.0.operation", "LOWER_CASE"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportStateVerifyIgnore:              []string{"publish"},
+			},
+		},
+	})
+}
+
+func testAccCheckRecipeExists(ctx context.Context, n string, v *databrew.DescribeRecipeOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], tfdatabrew.RecipeVersionLatestWorking)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRecipeDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_recipe" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRecipeByTwoPartKey(ctx, conn, rs.Primary.Attributes[names.AttrName], tfdatabrew.RecipeVersionLatestWorking)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Recipe %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccRecipeConfig_basic(rName, operation string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name = %[1]q
+
+  step {
+    action {
+      operation = %[2]q
+      parameters = {
+        sourceColumn = "name"
+      }
+    }
+  }
+}
+`, rName, operation)
+}
+
+func testAccRecipeConfig_publish(rName, operation string) string {
+	return fmt.Sprintf(`
+resource "aws_databrew_recipe" "test" {
+  name        = %[1]q
+  description = "published"
+  publish     = true
+
+  step {
+    action {
+      operation = %[2]q
+      parameters = {
+        sourceColumn = "name"
+      }
+    }
+  }
+}
+`, rName, operation)
+}
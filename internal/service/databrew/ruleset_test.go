@@ -0,0 +1,194 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package databrew_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/databrew"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatabrew "github.com/hashicorp/terraform-provider-aws/internal/service/databrew"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataBrewRuleset_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+	var v databrew.DescribeRulesetOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrRegionalARNFormat(ctx, resourceName, names.AttrARN, "databrew", "ruleset/{name}"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.check_expression", "AGG(MISSING_VALUES_PERCENTAGE) == :val1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.disabled", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.substitution_map.:val1", "0"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrTargetARN, "aws_databrew_dataset.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+			},
+			{
+				Config: testAccRulesetConfig_updated(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.column_selector.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.column_selector.0.name", "id"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.threshold.0.type", "LESS_THAN_OR_EQUAL"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.threshold.0.unit", "PERCENTAGE"),
+					resource.TestCheckResourceAttr(resourceName, "rule.1.threshold.0.value", "10"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataBrewRuleset_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_databrew_ruleset.test"
+	var v databrew.DescribeRulesetOutput
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataBrewServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRulesetDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRulesetConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRulesetExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatabrew.ResourceRuleset, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRulesetExists(ctx context.Context, n string, v *databrew.DescribeRulesetOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		output, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckRulesetDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataBrewClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_databrew_ruleset" {
+				continue
+			}
+
+			_, err := tfdatabrew.FindRulesetByName(ctx, conn, rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataBrew Ruleset %s still exists", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccRulesetConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name       = %[1]q
+  target_arn = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "no-missing-values"
+    check_expression = "AGG(MISSING_VALUES_PERCENTAGE) == :val1"
+    substitution_map = {
+      ":val1" = "0"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccRulesetConfig_updated(rName string) string {
+	return acctest.ConfigCompose(testAccDatasetConfig_basic(rName), fmt.Sprintf(`
+resource "aws_databrew_ruleset" "test" {
+  name        = %[1]q
+  description = "updated"
+  target_arn  = aws_databrew_dataset.test.arn
+
+  rule {
+    name             = "no-missing-values"
+    check_expression = "AGG(MISSING_VALUES_PERCENTAGE) == :val1"
+    substitution_map = {
+      ":val1" = "0"
+    }
+  }
+
+  rule {
+    name             = "positive-ids"
+    check_expression = ":col > :val1"
+    substitution_map = {
+      ":val1" = "0"
+    }
+
+    column_selector {
+      name = "id"
+    }
+
+    threshold {
+      type  = "LESS_THAN_OR_EQUAL"
+      unit  = "PERCENTAGE"
+      value = 10
+    }
+  }
+}
+`, rName))
+}
@@ -5,6 +5,7 @@ package dlm
 
 import (
 	"context"
+	"errors"
 	"log"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/dlm"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/dlm/types"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -39,11 +41,19 @@ func resourceLifecyclePolicy() *schema.Resource {
 			StateContext: schema.ImportStatePassthroughContext,
 		},
 
+		CustomizeDiff: resourceLifecyclePolicyCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			"default_policy": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.DefaultPolicyTypeValues](),
+			},
 			names.AttrDescription: {
 				Type:     schema.TypeString,
 				Required: true,
@@ -132,6 +142,31 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"copy_tags": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"create_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(1, 7),
+						},
+						"cross_region_copy_target": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							MaxItems: 3,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"target_region": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidRegionName,
+									},
+								},
+							},
+						},
 						"event_source": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -174,6 +209,50 @@ func resourceLifecyclePolicy() *schema.Resource {
 								},
 							},
 						},
+						"exclusions": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"exclude_boot_volumes": {
+										Type:     schema.TypeBool,
+										Optional: true,
+									},
+									"exclude_tags": {
+										Type:     schema.TypeMap,
+										Optional: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"exclude_volume_types": {
+										Type:     schema.TypeList,
+										Optional: true,
+										MaxItems: 6,
+										Elem: &schema.Schema{
+											Type:         schema.TypeString,
+											ValidateFunc: validation.StringInSlice([]string{"gp2", "gp3", "io1", "io2", "sc1", "st1", "standard"}, false),
+										},
+									},
+								},
+							},
+						},
+						"extend_deletion": {
+							Type:     schema.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+						"policy_language": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.PolicyLanguageValues](),
+						},
+						names.AttrResourceType: {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.ResourceTypeValues](),
+						},
 						"resource_types": {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -216,6 +295,12 @@ func resourceLifecyclePolicy() *schema.Resource {
 							Default:          awstypes.PolicyTypeValuesEbsSnapshotManagement,
 							ValidateDiagFunc: enum.Validate[awstypes.PolicyTypeValues](),
 						},
+						"retain_interval": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(2, 14),
+						},
 						names.AttrSchedule: {
 							Type:     schema.TypeList,
 							Optional: true,
@@ -330,9 +415,14 @@ func resourceLifecyclePolicy() *schema.Resource {
 												},
 												names.AttrTarget: {
 													Type:         schema.TypeString,
-													Required:     true,
+													Optional:     true,
 													ValidateFunc: validation.StringMatch(regexache.MustCompile(`^[\w:\-\/\*]+$`), ""),
 												},
+												"target_region": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: verify.ValidRegionName,
+												},
 											},
 										},
 									},
@@ -493,11 +583,15 @@ func resourceLifecyclePolicyCreate(ctx context.Context, d *schema.ResourceData,
 	input := dlm.CreateLifecyclePolicyInput{
 		Description:      aws.String(d.Get(names.AttrDescription).(string)),
 		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
-		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]any)),
+		PolicyDetails:    expandPolicyDetails(d.Get("policy_details").([]any), d.Get("default_policy").(string), d.GetRawConfig().GetAttr("policy_details")),
 		State:            awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string)),
 		Tags:             getTagsIn(ctx),
 	}
 
+	if v, ok := d.GetOk("default_policy"); ok {
+		input.DefaultPolicy = awstypes.DefaultPolicyTypeValues(v.(string))
+	}
+
 	out, err := tfresource.RetryWhenIsA[*awstypes.InvalidRequestException](ctx, createRetryTimeout, func() (any, error) {
 		return conn.CreateLifecyclePolicy(ctx, &input)
 	})
@@ -529,6 +623,11 @@ func resourceLifecyclePolicyRead(ctx context.Context, d *schema.ResourceData, me
 	}
 
 	d.Set(names.AttrARN, out.Policy.PolicyArn)
+	if aws.ToBool(out.Policy.DefaultPolicy) && out.Policy.PolicyDetails != nil {
+		d.Set("default_policy", out.Policy.PolicyDetails.ResourceType)
+	} else {
+		d.Set("default_policy", nil)
+	}
 	d.Set(names.AttrDescription, out.Policy.Description)
 	d.Set(names.AttrExecutionRoleARN, out.Policy.ExecutionRoleArn)
 	d.Set(names.AttrState, out.Policy.State)
@@ -560,7 +659,7 @@ func resourceLifecyclePolicyUpdate(ctx context.Context, d *schema.ResourceData,
 			input.State = awstypes.SettablePolicyStateValues(d.Get(names.AttrState).(string))
 		}
 		if d.HasChange("policy_details") {
-			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]any))
+			input.PolicyDetails = expandPolicyDetails(d.Get("policy_details").([]any), d.Get("default_policy").(string), d.GetRawConfig().GetAttr("policy_details"))
 		}
 
 		log.Printf("[INFO] Updating lifecycle policy %s", d.Id())
@@ -619,7 +718,63 @@ func findLifecyclePolicyByID(ctx context.Context, conn *dlm.Client, id string) (
 	return output, nil
 }
 
-func expandPolicyDetails(cfg []any) *awstypes.PolicyDetails {
+func resourceLifecyclePolicyCustomizeDiff(_ context.Context, diff *schema.ResourceDiff, meta any) error {
+	// Each cross-Region copy rule must name exactly one of target or target_region.
+	// The rules are nested in a set, so this can't be expressed with ExactlyOneOf.
+	for _, policyDetails := range rawConfigElements(diff.GetRawConfig().GetAttr("policy_details")) {
+		for _, schedule := range rawConfigElements(policyDetails.GetAttr(names.AttrSchedule)) {
+			for _, rule := range rawConfigElements(schedule.GetAttr("cross_region_copy_rule")) {
+				target, targetRegion := rule.GetAttr(names.AttrTarget), rule.GetAttr("target_region")
+
+				if !target.IsKnown() || !targetRegion.IsKnown() {
+					continue
+				}
+
+				if target.IsNull() == targetRegion.IsNull() {
+					return errors.New(`exactly one of "target" or "target_region" must be specified in each "cross_region_copy_rule"`)
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// rawConfigElements returns the elements of a known, non-null list or set configuration value.
+func rawConfigElements(v cty.Value) []cty.Value {
+	if !v.IsKnown() || v.IsNull() || !v.CanIterateElements() {
+		return nil
+	}
+
+	var elements []cty.Value
+
+	for it := v.ElementIterator(); it.Next(); {
+		_, e := it.Element()
+
+		if !e.IsKnown() || e.IsNull() {
+			continue
+		}
+
+		elements = append(elements, e)
+	}
+
+	return elements
+}
+
+// rawPolicyDetailsAttrConfigured returns whether the named attribute of the policy_details block is set in configuration.
+func rawPolicyDetailsAttrConfigured(rawPolicyDetails cty.Value, name string) bool {
+	elements := rawConfigElements(rawPolicyDetails)
+
+	if len(elements) == 0 {
+		return false
+	}
+
+	v := elements[0].GetAttr(name)
+
+	return v.IsKnown() && !v.IsNull()
+}
+
+func expandPolicyDetails(cfg []any, defaultPolicyType string, rawPolicyDetails cty.Value) *awstypes.PolicyDetails {
 	if len(cfg) == 0 || cfg[0] == nil {
 		return nil
 	}
@@ -629,6 +784,38 @@ func expandPolicyDetails(cfg []any) *awstypes.PolicyDetails {
 	policyDetails := &awstypes.PolicyDetails{
 		PolicyType: awstypes.PolicyTypeValues(policyType),
 	}
+	if v, ok := m["policy_language"].(string); ok && v != "" {
+		policyDetails.PolicyLanguage = awstypes.PolicyLanguageValues(v)
+	} else if defaultPolicyType != "" {
+		policyDetails.PolicyLanguage = awstypes.PolicyLanguageValuesSimplified
+	}
+	if v, ok := m[names.AttrResourceType].(string); ok && v != "" {
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(v)
+	} else if defaultPolicyType != "" {
+		policyDetails.ResourceType = awstypes.ResourceTypeValues(defaultPolicyType)
+	}
+	// The remaining settings apply to default policies only.
+	if policyDetails.PolicyLanguage == awstypes.PolicyLanguageValuesSimplified {
+		// copy_tags and extend_deletion are Optional+Computed, so only send them when configured.
+		if v, ok := m["copy_tags"].(bool); ok && rawPolicyDetailsAttrConfigured(rawPolicyDetails, "copy_tags") {
+			policyDetails.CopyTags = aws.Bool(v)
+		}
+		if v, ok := m["create_interval"].(int); ok && v > 0 {
+			policyDetails.CreateInterval = aws.Int32(int32(v))
+		}
+		if v, ok := m["cross_region_copy_target"].(*schema.Set); ok && v.Len() > 0 {
+			policyDetails.CrossRegionCopyTargets = expandCrossRegionCopyTargets(v.List())
+		}
+		if v, ok := m["exclusions"].([]any); ok && len(v) > 0 {
+			policyDetails.Exclusions = expandExclusions(v)
+		}
+		if v, ok := m["extend_deletion"].(bool); ok && rawPolicyDetailsAttrConfigured(rawPolicyDetails, "extend_deletion") {
+			policyDetails.ExtendDeletion = aws.Bool(v)
+		}
+		if v, ok := m["retain_interval"].(int); ok && v > 0 {
+			policyDetails.RetainInterval = aws.Int32(int32(v))
+		}
+	}
 	if v, ok := m["resource_types"].([]any); ok && len(v) > 0 {
 		policyDetails.ResourceTypes = flex.ExpandStringyValueList[awstypes.ResourceTypeValues](v)
 	}
@@ -663,6 +850,14 @@ func flattenPolicyDetails(policyDetails *awstypes.PolicyDetails) []map[string]an
 	result[names.AttrSchedule] = flattenSchedules(policyDetails.Schedules)
 	result["target_tags"] = flattenTags(policyDetails.TargetTags)
 	result["policy_type"] = string(policyDetails.PolicyType)
+	result["policy_language"] = string(policyDetails.PolicyLanguage)
+	result[names.AttrResourceType] = string(policyDetails.ResourceType)
+	result["copy_tags"] = aws.ToBool(policyDetails.CopyTags)
+	result["create_interval"] = aws.ToInt32(policyDetails.CreateInterval)
+	result["cross_region_copy_target"] = flattenCrossRegionCopyTargets(policyDetails.CrossRegionCopyTargets)
+	result["exclusions"] = flattenExclusions(policyDetails.Exclusions)
+	result["extend_deletion"] = aws.ToBool(policyDetails.ExtendDeletion)
+	result["retain_interval"] = aws.ToInt32(policyDetails.RetainInterval)
 
 	if policyDetails.Parameters != nil {
 		result[names.AttrParameters] = flattenParameters(policyDetails.Parameters)
@@ -951,6 +1146,9 @@ func expandCrossRegionCopyRules(l []any) []awstypes.CrossRegionCopyRule {
 		if v, ok := m[names.AttrTarget].(string); ok && v != "" {
 			rule.Target = aws.String(v)
 		}
+		if v, ok := m["target_region"].(string); ok && v != "" {
+			rule.TargetRegion = aws.String(v)
+		}
 
 		rules = append(rules, rule)
 	}
@@ -973,6 +1171,7 @@ func flattenCrossRegionCopyRules(rules []awstypes.CrossRegionCopyRule) []any {
 			names.AttrEncrypted: aws.ToBool(rule.Encrypted),
 			"retain_rule":       flattenCrossRegionCopyRuleRetainRule(rule.RetainRule),
 			names.AttrTarget:    aws.ToString(rule.Target),
+			"target_region":     aws.ToString(rule.TargetRegion),
 		}
 
 		result = append(result, m)
@@ -981,6 +1180,69 @@ func flattenCrossRegionCopyRules(rules []awstypes.CrossRegionCopyRule) []any {
 	return result
 }
 
+func expandCrossRegionCopyTargets(l []any) []awstypes.CrossRegionCopyTarget {
+	var targets []awstypes.CrossRegionCopyTarget
+
+	for _, tfMapRaw := range l {
+		m, ok := tfMapRaw.(map[string]any)
+
+		if !ok {
+			continue
+		}
+
+		targets = append(targets, awstypes.CrossRegionCopyTarget{
+			TargetRegion: aws.String(m["target_region"].(string)),
+		})
+	}
+
+	return targets
+}
+
+func flattenCrossRegionCopyTargets(targets []awstypes.CrossRegionCopyTarget) []any {
+	result := make([]any, 0, len(targets))
+
+	for _, target := range targets {
+		result = append(result, map[string]any{
+			"target_region": aws.ToString(target.TargetRegion),
+		})
+	}
+
+	return result
+}
+
+func expandExclusions(cfg []any) *awstypes.Exclusions {
+	if len(cfg) == 0 || cfg[0] == nil {
+		return nil
+	}
+	m := cfg[0].(map[string]any)
+
+	exclusions := &awstypes.Exclusions{}
+	if v, ok := m["exclude_boot_volumes"].(bool); ok {
+		exclusions.ExcludeBootVolumes = aws.Bool(v)
+	}
+	if v, ok := m["exclude_tags"].(map[string]any); ok && len(v) > 0 {
+		exclusions.ExcludeTags = expandTags(v)
+	}
+	if v, ok := m["exclude_volume_types"].([]any); ok && len(v) > 0 {
+		exclusions.ExcludeVolumeTypes = flex.ExpandStringValueList(v)
+	}
+
+	return exclusions
+}
+
+func flattenExclusions(exclusions *awstypes.Exclusions) []map[string]any {
+	if exclusions == nil {
+		return nil
+	}
+
+	result := make(map[string]any)
+	result["exclude_boot_volumes"] = aws.ToBool(exclusions.ExcludeBootVolumes)
+	result["exclude_tags"] = flattenTags(exclusions.ExcludeTags)
+	result["exclude_volume_types"] = exclusions.ExcludeVolumeTypes
+
+	return []map[string]any{result}
+}
+
 func expandCrossRegionCopyRuleDeprecateRule(l []any) *awstypes.CrossRegionCopyDeprecateRule {
 	if len(l) == 0 || l[0] == nil {
 		return nil
@@ -0,0 +1,240 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ec2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_vpc_security_group_rules_exclusive", name="Security Group Rules Exclusive")
+func newSecurityGroupRulesExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &securityGroupRulesExclusiveResource{}, nil
+}
+
+const (
+	ResNameSecurityGroupRulesExclusive = "Security Group Rules Exclusive"
+)
+
+type securityGroupRulesExclusiveResource struct {
+	framework.ResourceWithModel[securityGroupRulesExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *securityGroupRulesExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"egress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"ingress_rule_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *securityGroupRulesExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncRules(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionCreating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().EC2Client(ctx)
+
+	var state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ingress, egress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, state.SecurityGroupID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EC2, create.ErrActionReading, ResNameSecurityGroupRulesExclusive, state.SecurityGroupID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Only rule directions which are configured are managed exclusively.
+	if !state.EgressRuleIDs.IsNull() {
+		state.EgressRuleIDs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, egress)
+	}
+	if !state.IngressRuleIDs.IsNull() {
+		state.IngressRuleIDs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, ingress)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *securityGroupRulesExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state securityGroupRulesExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.EgressRuleIDs.Equal(state.EgressRuleIDs) || !plan.IngressRuleIDs.Equal(state.IngressRuleIDs) {
+		err := r.syncRules(ctx, plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.EC2, create.ErrActionUpdating, ResNameSecurityGroupRulesExclusive, plan.SecurityGroupID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncRules handles keeping the configured security group rules in sync
+// with the remote resource.
+//
+// Rules present on the security group but not configured on this resource
+// will be revoked. Configured rules which do not exist on the security group
+// cannot be created by this resource and will return an error.
+func (r *securityGroupRulesExclusiveResource) syncRules(ctx context.Context, plan securityGroupRulesExclusiveResourceModel) error {
+	conn := r.Meta().EC2Client(ctx)
+	groupID := plan.SecurityGroupID.ValueString()
+
+	haveIngress, haveEgress, err := findSecurityGroupRuleIDsBySecurityGroupID(ctx, conn, groupID)
+	if err != nil {
+		return err
+	}
+
+	if !plan.IngressRuleIDs.IsNull() {
+		want := flex.ExpandFrameworkStringValueSet(ctx, plan.IngressRuleIDs)
+		missing, remove, _ := intflex.DiffSlices(haveIngress, want, func(s1, s2 string) bool { return s1 == s2 })
+
+		if len(missing) > 0 {
+			return fmt.Errorf("ingress rules not found in security group (%s): %v", groupID, missing)
+		}
+
+		if len(remove) > 0 {
+			input := ec2.RevokeSecurityGroupIngressInput{
+				GroupId:              aws.String(groupID),
+				SecurityGroupRuleIds: remove,
+			}
+
+			if _, err := conn.RevokeSecurityGroupIngress(ctx, &input); err != nil {
+				return err
+			}
+		}
+	}
+
+	if !plan.EgressRuleIDs.IsNull() {
+		want := flex.ExpandFrameworkStringValueSet(ctx, plan.EgressRuleIDs)
+		missing, remove, _ := intflex.DiffSlices(haveEgress, want, func(s1, s2 string) bool { return s1 == s2 })
+
+		if len(missing) > 0 {
+			return fmt.Errorf("egress rules not found in security group (%s): %v", groupID, missing)
+		}
+
+		if len(remove) > 0 {
+			input := ec2.RevokeSecurityGroupEgressInput{
+				GroupId:              aws.String(groupID),
+				SecurityGroupRuleIds: remove,
+			}
+
+			if _, err := conn.RevokeSecurityGroupEgress(ctx, &input); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (r *securityGroupRulesExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("security_group_id"), req, resp)
+
+	// Manage both rule directions exclusively following import.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("egress_rule_ids"), fwtypes.NewSetValueOfMust[types.String](ctx, nil))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("ingress_rule_ids"), fwtypes.NewSetValueOfMust[types.String](ctx, nil))...)
+}
+
+func findSecurityGroupRuleIDsBySecurityGroupID(ctx context.Context, conn *ec2.Client, id string) ([]string, []string, error) {
+	// Ensure the security group exists, as describing rules for a non-existent group returns no error.
+	if _, err := findSecurityGroupByID(ctx, conn, id); err != nil {
+		return nil, nil, err
+	}
+
+	rules, err := findSecurityGroupRulesBySecurityGroupID(ctx, conn, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var ingress, egress []string
+	for _, rule := range rules {
+		if aws.ToBool(rule.IsEgress) {
+			egress = append(egress, aws.ToString(rule.SecurityGroupRuleId))
+		} else {
+			ingress = append(ingress, aws.ToString(rule.SecurityGroupRuleId))
+		}
+	}
+
+	return ingress, egress, nil
+}
+
+type securityGroupRulesExclusiveResourceModel struct {
+	framework.WithRegionModel
+	EgressRuleIDs   fwtypes.SetOfString `tfsdk:"egress_rule_ids"`
+	IngressRuleIDs  fwtypes.SetOfString `tfsdk:"ingress_rule_ids"`
+	SecurityGroupID types.String        `tfsdk:"security_group_id"`
+}
@@ -75,12 +75,30 @@ func expandLoadBalancers(tfList []any) []awstypes.LoadBalancer {
 			apiObject.TargetGroupArn = aws.String(v.(string))
 		}
 
+		if v, ok := tfMap["advanced_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+			apiObject.AdvancedConfiguration = expandAdvancedConfiguration(v[0].(map[string]any))
+		}
+
 		apiObjects = append(apiObjects, apiObject)
 	}
 
 	return apiObjects
 }
 
+func expandAdvancedConfiguration(tfMap map[string]any) *awstypes.AdvancedConfiguration {
+	apiObject := &awstypes.AdvancedConfiguration{
+		AlternateTargetGroupArn: aws.String(tfMap["alternate_target_group_arn"].(string)),
+		ProductionListenerRule:  aws.String(tfMap["production_listener_rule"].(string)),
+		RoleArn:                 aws.String(tfMap[names.AttrRoleARN].(string)),
+	}
+
+	if v, ok := tfMap["test_listener_rule"].(string); ok && v != "" {
+		apiObject.TestListenerRule = aws.String(v)
+	}
+
+	return apiObject
+}
+
 func flattenLoadBalancers(apiObjects []awstypes.LoadBalancer) []any {
 	tfList := make([]any, 0, len(apiObjects))
 
@@ -98,6 +116,15 @@ func flattenLoadBalancers(apiObjects []awstypes.LoadBalancer) []any {
 			tfMap["target_group_arn"] = aws.ToString(apiObject.TargetGroupArn)
 		}
 
+		if v := apiObject.AdvancedConfiguration; v != nil {
+			tfMap["advanced_configuration"] = []any{map[string]any{
+				"alternate_target_group_arn": aws.ToString(v.AlternateTargetGroupArn),
+				"production_listener_rule":   aws.ToString(v.ProductionListenerRule),
+				names.AttrRoleARN:            aws.ToString(v.RoleArn),
+				"test_listener_rule":         aws.ToString(v.TestListenerRule),
+			}}
+		}
+
 		tfList = append(tfList, tfMap)
 	}
 
@@ -610,6 +610,54 @@ func resourceService() *schema.Resource {
 					},
 				},
 			},
+			"deployment_configuration": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bake_time_in_minutes": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Computed:     true,
+							ValidateFunc: validation.IntBetween(0, 1440),
+						},
+						"lifecycle_hook": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"hook_target_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"lifecycle_stages": {
+										Type:     schema.TypeList,
+										Required: true,
+										Elem: &schema.Schema{
+											Type:             schema.TypeString,
+											ValidateDiagFunc: enum.Validate[awstypes.DeploymentLifecycleHookStage](),
+										},
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
+						"strategy": {
+							Type:             schema.TypeString,
+							Optional:         true,
+							Computed:         true,
+							ValidateDiagFunc: enum.Validate[awstypes.DeploymentStrategy](),
+						},
+					},
+				},
+			},
 			"deployment_controller": {
 				Type:             schema.TypeList,
 				Optional:         true,
@@ -697,6 +745,35 @@ func resourceService() *schema.Resource {
 				Optional: true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
+						"advanced_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"alternate_target_group_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"production_listener_rule": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									names.AttrRoleARN: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"test_listener_rule": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+								},
+							},
+						},
 						"container_name": {
 							Type:     schema.TypeString,
 							Required: true,
@@ -1214,6 +1291,10 @@ func resourceServiceCreate(ctx context.Context, d *schema.ResourceData, meta any
 		input.DeploymentConfiguration.DeploymentCircuitBreaker = expandDeploymentCircuitBreaker(v.([]any)[0].(map[string]any))
 	}
 
+	if v, ok := d.GetOk("deployment_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		expandDeploymentStrategyConfiguration(v.([]any)[0].(map[string]any), input.DeploymentConfiguration)
+	}
+
 	if v, ok := d.GetOk("health_check_grace_period_seconds"); ok {
 		input.HealthCheckGracePeriodSeconds = aws.Int32(int32(v.(int)))
 	}
@@ -1366,6 +1447,10 @@ func resourceServiceRead(ctx context.Context, d *schema.ResourceData, meta any)
 		} else {
 			d.Set("deployment_circuit_breaker", nil)
 		}
+
+		if err := d.Set("deployment_configuration", flattenDeploymentStrategyConfiguration(service.DeploymentConfiguration)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting deployment_configuration: %s", err)
+		}
 	}
 	if err := d.Set("deployment_controller", flattenDeploymentController(service.DeploymentController)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting deployment_controller: %s", err)
@@ -1484,6 +1569,19 @@ func resourceServiceUpdate(ctx context.Context, d *schema.ResourceData, meta any
 			}
 		}
 
+		if d.HasChange("deployment_configuration") {
+			if input.DeploymentConfiguration == nil {
+				input.DeploymentConfiguration = &awstypes.DeploymentConfiguration{}
+			}
+
+			// To remove existing lifecycle hooks, specify an empty list.
+			input.DeploymentConfiguration.LifecycleHooks = []awstypes.DeploymentLifecycleHook{}
+
+			if v, ok := d.GetOk("deployment_configuration"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+				expandDeploymentStrategyConfiguration(v.([]any)[0].(map[string]any), input.DeploymentConfiguration)
+			}
+		}
+
 		switch schedulingStrategy := awstypes.SchedulingStrategy(d.Get("scheduling_strategy").(string)); schedulingStrategy {
 		case awstypes.SchedulingStrategyDaemon:
 			if d.HasChange("deployment_minimum_healthy_percent") {
@@ -2123,6 +2221,67 @@ func flattenDeploymentController(apiObject *awstypes.DeploymentController) []any
 	return []any{tfMap}
 }
 
+// expandDeploymentStrategyConfiguration sets the deployment strategy, bake time and lifecycle hooks of apiObject.
+func expandDeploymentStrategyConfiguration(tfMap map[string]any, apiObject *awstypes.DeploymentConfiguration) {
+	if v, ok := tfMap["bake_time_in_minutes"].(int); ok && v != 0 {
+		apiObject.BakeTimeInMinutes = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["lifecycle_hook"].(*schema.Set); ok && v.Len() > 0 {
+		apiObject.LifecycleHooks = expandDeploymentLifecycleHooks(v.List())
+	}
+
+	if v, ok := tfMap["strategy"].(string); ok && v != "" {
+		apiObject.Strategy = awstypes.DeploymentStrategy(v)
+	}
+}
+
+func expandDeploymentLifecycleHooks(tfList []any) []awstypes.DeploymentLifecycleHook {
+	apiObjects := make([]awstypes.DeploymentLifecycleHook, 0, len(tfList))
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObjects = append(apiObjects, awstypes.DeploymentLifecycleHook{
+			HookTargetArn:   aws.String(tfMap["hook_target_arn"].(string)),
+			LifecycleStages: flex.ExpandStringyValueList[awstypes.DeploymentLifecycleHookStage](tfMap["lifecycle_stages"].([]any)),
+			RoleArn:         aws.String(tfMap[names.AttrRoleARN].(string)),
+		})
+	}
+
+	return apiObjects
+}
+
+func flattenDeploymentStrategyConfiguration(apiObject *awstypes.DeploymentConfiguration) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"bake_time_in_minutes": aws.ToInt32(apiObject.BakeTimeInMinutes),
+		"strategy":             apiObject.Strategy,
+	}
+
+	if len(apiObject.LifecycleHooks) > 0 {
+		tfList := make([]any, 0, len(apiObject.LifecycleHooks))
+
+		for _, v := range apiObject.LifecycleHooks {
+			tfList = append(tfList, map[string]any{
+				"hook_target_arn":  aws.ToString(v.HookTargetArn),
+				"lifecycle_stages": flex.FlattenStringyValueList(v.LifecycleStages),
+				names.AttrRoleARN:  aws.ToString(v.RoleArn),
+			})
+		}
+
+		tfMap["lifecycle_hook"] = tfList
+	}
+
+	return []any{tfMap}
+}
+
 func expandDeploymentCircuitBreaker(tfMap map[string]any) *awstypes.DeploymentCircuitBreaker {
 	if tfMap == nil {
 		return nil
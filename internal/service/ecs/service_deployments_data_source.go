@@ -0,0 +1,196 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/ecs/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_ecs_service_deployments", name="Service Deployments")
+func newServiceDeploymentsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &serviceDeploymentsDataSource{}, nil
+}
+
+type serviceDeploymentsDataSource struct {
+	framework.DataSourceWithModel[serviceDeploymentsDataSourceModel]
+}
+
+func (d *serviceDeploymentsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster": schema.StringAttribute{
+				Required: true,
+			},
+			"service": schema.StringAttribute{
+				Required: true,
+			},
+			"service_deployments": framework.DataSourceComputedListOfObjectAttribute[serviceDeploymentModel](ctx),
+			names.AttrStatus: schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringEnumType[awstypes.ServiceDeploymentStatus](),
+				ElementType: fwtypes.StringEnumType[awstypes.ServiceDeploymentStatus](),
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func (d *serviceDeploymentsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data serviceDeploymentsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ECSClient(ctx)
+
+	var input ecs.ListServiceDeploymentsInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findServiceDeployments(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading ECS Service (%s) deployments", data.Service.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.ServiceDeployments)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func listServiceDeployments(ctx context.Context, conn *ecs.Client, input *ecs.ListServiceDeploymentsInput) ([]awstypes.ServiceDeploymentBrief, error) {
+	var output []awstypes.ServiceDeploymentBrief
+
+	for {
+		page, err := conn.ListServiceDeployments(ctx, input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ServiceDeployments...)
+
+		if aws.ToString(page.NextToken) == "" {
+			break
+		}
+
+		input.NextToken = page.NextToken
+	}
+
+	return output, nil
+}
+
+func findServiceDeployments(ctx context.Context, conn *ecs.Client, input *ecs.ListServiceDeploymentsInput) ([]awstypes.ServiceDeployment, error) {
+	briefs, err := listServiceDeployments(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	arns := tfslices.ApplyToAll(briefs, func(v awstypes.ServiceDeploymentBrief) string {
+		return aws.ToString(v.ServiceDeploymentArn)
+	})
+
+	var output []awstypes.ServiceDeployment
+
+	// DescribeServiceDeployments accepts at most 20 ARNs per call.
+	const (
+		batchSize = 20
+	)
+	for chunk := range slices.Chunk(arns, batchSize) {
+		input := ecs.DescribeServiceDeploymentsInput{
+			ServiceDeploymentArns: chunk,
+		}
+
+		page, err := conn.DescribeServiceDeployments(ctx, &input)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(page.Failures) > 0 {
+			return nil, errors.Join(tfslices.ApplyToAll(page.Failures, func(v awstypes.Failure) error {
+				return failureError(&v)
+			})...)
+		}
+
+		output = append(output, page.ServiceDeployments...)
+	}
+
+	return output, nil
+}
+
+type serviceDeploymentsDataSourceModel struct {
+	framework.WithRegionModel
+	Cluster            types.String                                              `tfsdk:"cluster"`
+	Service            types.String                                              `tfsdk:"service"`
+	ServiceDeployments fwtypes.ListNestedObjectValueOf[serviceDeploymentModel]   `tfsdk:"service_deployments"`
+	Status             fwtypes.SetOfStringEnum[awstypes.ServiceDeploymentStatus] `tfsdk:"status"`
+}
+
+type serviceDeploymentModel struct {
+	Alarms                   fwtypes.ListNestedObjectValueOf[serviceDeploymentAlarmsModel]         `tfsdk:"alarms"`
+	ClusterARN               types.String                                                          `tfsdk:"cluster_arn"`
+	CreatedAt                timetypes.RFC3339                                                     `tfsdk:"created_at"`
+	DeploymentCircuitBreaker fwtypes.ListNestedObjectValueOf[serviceDeploymentCircuitBreakerModel] `tfsdk:"deployment_circuit_breaker"`
+	FinishedAt               timetypes.RFC3339                                                     `tfsdk:"finished_at"`
+	Rollback                 fwtypes.ListNestedObjectValueOf[rollbackModel]                        `tfsdk:"rollback"`
+	ServiceARN               types.String                                                          `tfsdk:"service_arn"`
+	ServiceDeploymentARN     types.String                                                          `tfsdk:"service_deployment_arn"`
+	SourceServiceRevisions   fwtypes.ListNestedObjectValueOf[serviceRevisionSummaryModel]          `tfsdk:"source_service_revisions"`
+	StartedAt                timetypes.RFC3339                                                     `tfsdk:"started_at"`
+	Status                   fwtypes.StringEnum[awstypes.ServiceDeploymentStatus]                  `tfsdk:"status"`
+	StatusReason             types.String                                                          `tfsdk:"status_reason"`
+	StoppedAt                timetypes.RFC3339                                                     `tfsdk:"stopped_at"`
+	TargetServiceRevision    fwtypes.ListNestedObjectValueOf[serviceRevisionSummaryModel]          `tfsdk:"target_service_revision"`
+	UpdatedAt                timetypes.RFC3339                                                     `tfsdk:"updated_at"`
+}
+
+type serviceDeploymentAlarmsModel struct {
+	AlarmNames          fwtypes.ListOfString                                                 `tfsdk:"alarm_names"`
+	Status              fwtypes.StringEnum[awstypes.ServiceDeploymentRollbackMonitorsStatus] `tfsdk:"status"`
+	TriggeredAlarmNames fwtypes.ListOfString                                                 `tfsdk:"triggered_alarm_names"`
+}
+
+type serviceDeploymentCircuitBreakerModel struct {
+	FailureCount types.Int32                                                          `tfsdk:"failure_count"`
+	Status       fwtypes.StringEnum[awstypes.ServiceDeploymentRollbackMonitorsStatus] `tfsdk:"status"`
+	Threshold    types.Int32                                                          `tfsdk:"threshold"`
+}
+
+type rollbackModel struct {
+	Reason             types.String      `tfsdk:"reason"`
+	ServiceRevisionARN types.String      `tfsdk:"service_revision_arn"`
+	StartedAt          timetypes.RFC3339 `tfsdk:"started_at"`
+}
+
+type serviceRevisionSummaryModel struct {
+	ARN                types.String `tfsdk:"arn"`
+	PendingTaskCount   types.Int32  `tfsdk:"pending_task_count"`
+	RequestedTaskCount types.Int32  `tfsdk:"requested_task_count"`
+	RunningTaskCount   types.Int32  `tfsdk:"running_task_count"`
+}
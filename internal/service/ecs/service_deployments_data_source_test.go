@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package ecs_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccECSServiceDeploymentsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_ecs_service_deployments.test"
+	resourceName := "aws_ecs_service.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceDeploymentsDataSourceConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_deployments.#", "1"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_deployments.0.cluster_arn", "aws_ecs_cluster.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_deployments.0.created_at"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "service_deployments.0.service_arn", resourceName, names.AttrID),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_deployments.0.service_deployment_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_deployments.0.status"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_deployments.0.target_service_revision.0.arn"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceDeploymentsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceConfig_basic(rName, rName), `
+data "aws_ecs_service_deployments" "test" {
+  cluster = aws_ecs_cluster.test.arn
+  service = aws_ecs_service.test.name
+}
+`)
+}
@@ -26,6 +26,12 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 			Name:     "Clusters",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newServiceDeploymentsDataSource,
+			TypeName: "aws_ecs_service_deployments",
+			Name:     "Service Deployments",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
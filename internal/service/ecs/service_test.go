@@ -981,6 +981,44 @@ func TestAccECSService_deploymentCircuitBreaker(t *testing.T) {
 	})
 }
 
+func TestAccECSService_blueGreenDeployment(t *testing.T) {
+	ctx := acctest.Context(t)
+	var service awstypes.Service
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_ecs_service.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ECSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckServiceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceConfig_blueGreenDeployment(rName, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "deployment_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_configuration.0.bake_time_in_minutes", "5"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_configuration.0.lifecycle_hook.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "deployment_configuration.0.lifecycle_hook.*.hook_target_arn", "aws_lambda_function.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "deployment_configuration.0.strategy", string(awstypes.DeploymentStrategyBlueGreen)),
+					resource.TestCheckResourceAttr(resourceName, "load_balancer.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "load_balancer.*.advanced_configuration.0.alternate_target_group_arn", "aws_lb_target_group.green", names.AttrARN),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "load_balancer.*.advanced_configuration.0.production_listener_rule", "aws_lb_listener_rule.production", names.AttrARN),
+				),
+			},
+			{
+				Config: testAccServiceConfig_blueGreenDeployment(rName, 10),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckServiceExists(ctx, resourceName, &service),
+					resource.TestCheckResourceAttr(resourceName, "deployment_configuration.0.bake_time_in_minutes", "10"),
+					resource.TestCheckResourceAttr(resourceName, "deployment_configuration.0.strategy", string(awstypes.DeploymentStrategyBlueGreen)),
+				),
+			},
+		},
+	})
+}
+
 // Regression for https://github.com/hashicorp/terraform/issues/3444
 func TestAccECSService_loadBalancerChanges(t *testing.T) {
 	ctx := acctest.Context(t)
@@ -3769,6 +3807,187 @@ resource "aws_ecs_service" "test" {
 `, rName))
 }
 
+func testAccServiceConfig_blueGreenDeployment(rName string, bakeTime int) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigVPCWithSubnets(rName, 2),
+		fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_ecs_cluster" "test" {
+  name = %[1]q
+}
+
+resource "aws_ecs_task_definition" "test" {
+  family = %[1]q
+
+  container_definitions = jsonencode([{
+    cpu       = 256
+    essential = true
+    image     = "nginx:latest"
+    memory    = 512
+    name      = "nginx"
+    portMappings = [{
+      containerPort = 80
+      hostPort      = 0
+    }]
+  }])
+}
+
+resource "aws_iam_role" "ecs_infrastructure" {
+  name = "%[1]s-infra"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "ecs.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_iam_role_policy_attachment" "ecs_infrastructure_load_balancers" {
+  role       = aws_iam_role.ecs_infrastructure.name
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonECSInfrastructureRolePolicyForLoadBalancers"
+}
+
+resource "aws_iam_role_policy" "ecs_infrastructure_lambda" {
+  name = %[1]q
+  role = aws_iam_role.ecs_infrastructure.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = "lambda:InvokeFunction"
+      Resource = aws_lambda_function.test.arn
+    }]
+  })
+}
+
+resource "aws_iam_role" "lambda" {
+  name = "%[1]s-lambda"
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect    = "Allow"
+      Principal = { Service = "lambda.amazonaws.com" }
+      Action    = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lb" "test" {
+  name     = %[1]q
+  internal = true
+  subnets  = aws_subnet.test[*].id
+}
+
+resource "aws_lb_target_group" "blue" {
+  name     = "%[1]s-b"
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+}
+
+resource "aws_lb_target_group" "green" {
+  name     = "%[1]s-g"
+  port     = 80
+  protocol = "HTTP"
+  vpc_id   = aws_vpc.test.id
+}
+
+resource "aws_lb_listener" "test" {
+  load_balancer_arn = aws_lb.test.id
+  port              = "80"
+  protocol          = "HTTP"
+
+  default_action {
+    type = "fixed-response"
+
+    fixed_response {
+      content_type = "text/plain"
+      status_code  = "404"
+    }
+  }
+}
+
+resource "aws_lb_listener_rule" "production" {
+  listener_arn = aws_lb_listener.test.arn
+
+  action {
+    type = "forward"
+
+    forward {
+      target_group {
+        arn    = aws_lb_target_group.blue.arn
+        weight = 100
+      }
+
+      target_group {
+        arn    = aws_lb_target_group.green.arn
+        weight = 0
+      }
+    }
+  }
+
+  condition {
+    path_pattern {
+      values = ["/*"]
+    }
+  }
+
+  lifecycle {
+    ignore_changes = [action]
+  }
+}
+
+resource "aws_ecs_service" "test" {
+  name            = %[1]q
+  cluster         = aws_ecs_cluster.test.id
+  task_definition = aws_ecs_task_definition.test.arn
+  desired_count   = 0
+
+  deployment_configuration {
+    strategy             = "BLUE_GREEN"
+    bake_time_in_minutes = %[2]d
+
+    lifecycle_hook {
+      hook_target_arn  = aws_lambda_function.test.arn
+      role_arn         = aws_iam_role.ecs_infrastructure.arn
+      lifecycle_stages = ["POST_TEST_TRAFFIC_SHIFT"]
+    }
+  }
+
+  load_balancer {
+    target_group_arn = aws_lb_target_group.blue.arn
+    container_name   = "nginx"
+    container_port   = 80
+
+    advanced_configuration {
+      alternate_target_group_arn = aws_lb_target_group.green.arn
+      production_listener_rule   = aws_lb_listener_rule.production.arn
+      role_arn                   = aws_iam_role.ecs_infrastructure.arn
+    }
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.ecs_infrastructure_load_balancers,
+    aws_iam_role_policy.ecs_infrastructure_lambda,
+  ]
+}
+`, rName, bakeTime))
+}
+
 func testAccServiceConfig_multipleTargetGroups(rName string) string {
 	return acctest.ConfigCompose(acctest.ConfigVPCWithSubnets(rName, 2), fmt.Sprintf(`
 resource "aws_ecs_cluster" "test" {
@@ -27,7 +27,9 @@ import (
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/internal/yaml"
 	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/xeipuuv/gojsonschema"
 )
 
 // @SDKResource("aws_eks_addon", name="Add-On")
@@ -49,6 +51,8 @@ func resourceAddon() *schema.Resource {
 			Delete: schema.DefaultTimeout(40 * time.Minute),
 		},
 
+		CustomizeDiff: validateAddonConfigurationValuesCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"addon_name": {
 				Type:         schema.TypeString,
@@ -436,6 +440,32 @@ func findAddonByTwoPartKey(ctx context.Context, conn *eks.Client, clusterName, a
 	return output.Addon, nil
 }
 
+func findAddonConfigurationSchemaByTwoPartKey(ctx context.Context, conn *eks.Client, addonName, addonVersion string) (string, error) {
+	input := &eks.DescribeAddonConfigurationInput{
+		AddonName:    aws.String(addonName),
+		AddonVersion: aws.String(addonVersion),
+	}
+
+	output, err := conn.DescribeAddonConfiguration(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return "", &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	if output == nil || output.ConfigurationSchema == nil {
+		return "", tfresource.NewEmptyResultError(input)
+	}
+
+	return aws.ToString(output.ConfigurationSchema), nil
+}
+
 func findAddonUpdateByThreePartKey(ctx context.Context, conn *eks.Client, clusterName, addonName, id string) (*types.Update, error) {
 	input := &eks.DescribeUpdateInput{
 		AddonName: aws.String(addonName),
@@ -558,6 +588,105 @@ func waitAddonUpdateSuccessful(ctx context.Context, conn *eks.Client, clusterNam
 	return nil, err
 }
 
+// validateAddonConfigurationValuesCustomizeDiff validates configuration_values against the
+// JSON schema published for the add-on version so that invalid values fail at plan time.
+func validateAddonConfigurationValuesCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.HasChanges("addon_version", "configuration_values") {
+		return nil
+	}
+
+	// Unknown or unset values can't be validated until apply.
+	if !d.NewValueKnown("addon_name") || !d.NewValueKnown("configuration_values") {
+		return nil
+	}
+
+	addonName, configurationValues := d.Get("addon_name").(string), d.Get("configuration_values").(string)
+	if addonName == "" || configurationValues == "" {
+		return nil
+	}
+
+	conn := meta.(*conns.AWSClient).EKSClient(ctx)
+
+	var addonVersion string
+	if d.NewValueKnown("addon_version") {
+		addonVersion = d.Get("addon_version").(string)
+	}
+
+	if addonVersion == "" {
+		// A configured version that is unknown at plan time can't be resolved.
+		if v := d.GetRawConfig().GetAttr("addon_version"); !v.IsKnown() || !v.IsNull() {
+			return nil
+		}
+
+		// EKS installs the default add-on version for the cluster's Kubernetes version.
+		if !d.NewValueKnown(names.AttrClusterName) {
+			return nil
+		}
+
+		clusterName := d.Get(names.AttrClusterName).(string)
+		cluster, err := findClusterByName(ctx, conn, clusterName)
+
+		// The cluster may be created in the same apply.
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EKS Cluster (%s): %w", clusterName, err)
+		}
+
+		kubernetesVersion := aws.ToString(cluster.Version)
+		addonVersionInfo, err := findAddonVersionByTwoPartKey(ctx, conn, addonName, kubernetesVersion, false)
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading EKS Add-On (%s) default version for Kubernetes version (%s): %w", addonName, kubernetesVersion, err)
+		}
+
+		addonVersion = aws.ToString(addonVersionInfo.AddonVersion)
+	}
+
+	configurationSchema, err := findAddonConfigurationSchemaByTwoPartKey(ctx, conn, addonName, addonVersion)
+
+	if tfresource.NotFound(err) {
+		return nil
+	}
+
+	if err != nil {
+		return fmt.Errorf("reading EKS Add-On (%s) version (%s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	if configurationSchema == "" {
+		return nil
+	}
+
+	// Configuration values may be supplied as either JSON or YAML; YAML is a superset of JSON.
+	var document any
+	if err := yaml.DecodeFromString(configurationValues, &document); err != nil {
+		return fmt.Errorf("decoding configuration_values: %w", err)
+	}
+
+	result, err := gojsonschema.Validate(gojsonschema.NewStringLoader(configurationSchema), gojsonschema.NewGoLoader(document))
+
+	if err != nil {
+		return fmt.Errorf("validating configuration_values against EKS Add-On (%s) version (%s) configuration schema: %w", addonName, addonVersion, err)
+	}
+
+	if !result.Valid() {
+		var validationErrs []error
+		for _, v := range result.Errors() {
+			validationErrs = append(validationErrs, errors.New(v.String()))
+		}
+
+		return fmt.Errorf("configuration_values is not valid for EKS Add-On (%s) version (%s): %w", addonName, addonVersion, errors.Join(validationErrs...))
+	}
+
+	return nil
+}
+
 func addonIssueError(apiObject types.AddonIssue) error {
 	return fmt.Errorf("%s: %s", apiObject.Code, aws.ToString(apiObject.Message))
 }
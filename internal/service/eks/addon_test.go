@@ -301,7 +301,31 @@ func TestAccEKSAddon_configurationValues(t *testing.T) {
 			},
 			{
 				Config:      testAccAddonConfig_configurationValues(rName, addonName, invalidConfigurationValues),
-				ExpectError: regexache.MustCompile(`InvalidParameterException: ConfigurationValue provided in request is not supported`),
+				ExpectError: regexache.MustCompile(`configuration_values is not valid for EKS Add-On`),
+			},
+		},
+	})
+}
+
+func TestAccEKSAddon_configurationValuesDefaultVersion(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	invalidConfigurationValues := "{\"env\": {\"INVALID_FIELD\":\"2\"}}"
+	addonName := "vpc-cni"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t); testAccPreCheckAddon(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAddonDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAddonConfig_base(rName),
+			},
+			{
+				// addon_version isn't set, so the default version's schema is used.
+				Config:      testAccAddonConfig_configurationValues(rName, addonName, invalidConfigurationValues),
+				ExpectError: regexache.MustCompile(`configuration_values is not valid for EKS Add-On`),
 			},
 		},
 	})
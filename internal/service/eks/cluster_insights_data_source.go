@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_eks_cluster_insights", name="Cluster Insights")
+func newClusterInsightsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &clusterInsightsDataSource{}, nil
+}
+
+const (
+	DSNameClusterInsights = "Cluster Insights Data Source"
+)
+
+type clusterInsightsDataSource struct {
+	framework.DataSourceWithModel[clusterInsightsDataSourceModel]
+}
+
+func (d *clusterInsightsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrClusterName: schema.StringAttribute{
+				Required: true,
+			},
+			"has_errors": schema.BoolAttribute{
+				Computed: true,
+			},
+			"insights": framework.DataSourceComputedListOfObjectAttribute[insightModel](ctx),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrFilter: schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[insightsFilterModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"categories": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.Category](),
+							ElementType: fwtypes.StringEnumType[awstypes.Category](),
+							Optional:    true,
+						},
+						"kubernetes_versions": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"statuses": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.InsightStatusValue](),
+							ElementType: fwtypes.StringEnumType[awstypes.InsightStatusValue](),
+							Optional:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterInsightsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	conn := d.Meta().EKSClient(ctx)
+
+	var data clusterInsightsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	input := eks.ListInsightsInput{}
+	resp.Diagnostics.Append(flex.Expand(ctx, data, &input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findInsights(ctx, conn, &input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.EKS, create.ErrActionReading, DSNameClusterInsights, data.ClusterName.ValueString(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(flex.Flatten(ctx, out, &data.Insights)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	hasErrors := false
+	for _, v := range out {
+		if v.InsightStatus != nil && v.InsightStatus.Status == awstypes.InsightStatusValueError {
+			hasErrors = true
+			break
+		}
+	}
+	data.HasErrors = types.BoolValue(hasErrors)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// findInsights lists the cluster's insights and describes each one, as only
+// DescribeInsight returns recommendations and affected resources.
+func findInsights(ctx context.Context, conn *eks.Client, input *eks.ListInsightsInput) ([]awstypes.Insight, error) {
+	var ids []string
+
+	pages := eks.NewListInsightsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Insights {
+			ids = append(ids, aws.ToString(v.Id))
+		}
+	}
+
+	out := make([]awstypes.Insight, 0, len(ids))
+
+	for _, id := range ids {
+		input := eks.DescribeInsightInput{
+			ClusterName: input.ClusterName,
+			Id:          aws.String(id),
+		}
+
+		output, err := conn.DescribeInsight(ctx, &input)
+
+		// Insights can disappear between listing and describing.
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if output == nil || output.Insight == nil {
+			continue
+		}
+
+		out = append(out, *output.Insight)
+	}
+
+	return out, nil
+}
+
+type clusterInsightsDataSourceModel struct {
+	framework.WithRegionModel
+	ClusterName types.String                                         `tfsdk:"cluster_name"`
+	Filter      fwtypes.ListNestedObjectValueOf[insightsFilterModel] `tfsdk:"filter"`
+	HasErrors   types.Bool                                           `tfsdk:"has_errors"`
+	Insights    fwtypes.ListNestedObjectValueOf[insightModel]        `tfsdk:"insights"`
+}
+
+type insightsFilterModel struct {
+	Categories         fwtypes.SetOfStringEnum[awstypes.Category]           `tfsdk:"categories"`
+	KubernetesVersions fwtypes.SetOfString                                  `tfsdk:"kubernetes_versions"`
+	Statuses           fwtypes.SetOfStringEnum[awstypes.InsightStatusValue] `tfsdk:"statuses"`
+}
+
+type insightModel struct {
+	AdditionalInfo          fwtypes.MapOfString                                                  `tfsdk:"additional_info"`
+	Category                fwtypes.StringEnum[awstypes.Category]                                `tfsdk:"category"`
+	CategorySpecificSummary fwtypes.ListNestedObjectValueOf[insightCategorySpecificSummaryModel] `tfsdk:"category_specific_summary"`
+	Description             types.String                                                         `tfsdk:"description"`
+	ID                      types.String                                                         `tfsdk:"id"`
+	InsightStatus           fwtypes.ListNestedObjectValueOf[insightStatusModel]                  `tfsdk:"insight_status"`
+	KubernetesVersion       types.String                                                         `tfsdk:"kubernetes_version"`
+	LastRefreshTime         timetypes.RFC3339                                                    `tfsdk:"last_refresh_time"`
+	LastTransitionTime      timetypes.RFC3339                                                    `tfsdk:"last_transition_time"`
+	Name                    types.String                                                         `tfsdk:"name"`
+	Recommendation          types.String                                                         `tfsdk:"recommendation"`
+	Resources               fwtypes.ListNestedObjectValueOf[insightResourceDetailModel]          `tfsdk:"resources"`
+}
+
+type insightCategorySpecificSummaryModel struct {
+	AddonCompatibilityDetails fwtypes.ListNestedObjectValueOf[addonCompatibilityDetailModel] `tfsdk:"addon_compatibility_details"`
+	DeprecationDetails        fwtypes.ListNestedObjectValueOf[deprecationDetailModel]        `tfsdk:"deprecation_details"`
+}
+
+type addonCompatibilityDetailModel struct {
+	CompatibleVersions fwtypes.ListOfString `tfsdk:"compatible_versions"`
+	Name               types.String         `tfsdk:"name"`
+}
+
+type deprecationDetailModel struct {
+	ClientStats                    fwtypes.ListNestedObjectValueOf[clientStatModel] `tfsdk:"client_stats"`
+	ReplacedWith                   types.String                                     `tfsdk:"replaced_with"`
+	StartServingReplacementVersion types.String                                     `tfsdk:"start_serving_replacement_version"`
+	StopServingVersion             types.String                                     `tfsdk:"stop_serving_version"`
+	Usage                          types.String                                     `tfsdk:"usage"`
+}
+
+type clientStatModel struct {
+	LastRequestTime            timetypes.RFC3339 `tfsdk:"last_request_time"`
+	NumberOfRequestsLast30Days types.Int32       `tfsdk:"number_of_requests_last_30_days"`
+	UserAgent                  types.String      `tfsdk:"user_agent"`
+}
+
+type insightStatusModel struct {
+	Reason types.String                                    `tfsdk:"reason"`
+	Status fwtypes.StringEnum[awstypes.InsightStatusValue] `tfsdk:"status"`
+}
+
+type insightResourceDetailModel struct {
+	ARN                   types.String                                        `tfsdk:"arn"`
+	InsightStatus         fwtypes.ListNestedObjectValueOf[insightStatusModel] `tfsdk:"insight_status"`
+	KubernetesResourceURI types.String                                        `tfsdk:"kubernetes_resource_uri"`
+}
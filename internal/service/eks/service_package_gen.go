@@ -31,6 +31,12 @@ func (p *servicePackage) EphemeralResources(ctx context.Context) []*inttypes.Ser
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.ServicePackageFrameworkDataSource {
 	return []*inttypes.ServicePackageFrameworkDataSource{
+		{
+			Factory:  newClusterInsightsDataSource,
+			TypeName: "aws_eks_cluster_insights",
+			Name:     "Cluster Insights",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newClusterVersionsDataSource,
 			TypeName: "aws_eks_cluster_versions",
@@ -0,0 +1,86 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_emrserverless_application", name="Application")
+// @Tags(identifierAttribute="arn")
+func dataSourceApplication() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceApplicationRead,
+
+		Schema: map[string]*schema.Schema{
+			"architecture": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrID: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"release_label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags: tftags.TagsSchemaComputed(),
+			names.AttrType: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func dataSourceApplicationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	id := d.Get(names.AttrID).(string)
+	application, err := findApplicationByID(ctx, conn, id)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Application (%s): %s", id, err)
+	}
+
+	d.SetId(aws.ToString(application.ApplicationId))
+	d.Set("architecture", application.Architecture)
+	d.Set(names.AttrARN, application.Arn)
+	d.Set(names.AttrName, application.Name)
+	d.Set("release_label", application.ReleaseLabel)
+	d.Set(names.AttrState, application.State)
+	d.Set("state_details", application.StateDetails)
+	d.Set(names.AttrType, strings.ToLower(aws.ToString(application.Type)))
+
+	setTagsOut(ctx, application.Tags)
+
+	return diags
+}
@@ -5,7 +5,10 @@ package emrserverless
 
 // Exports for use in tests only.
 var (
-	FindApplicationByID = findApplicationByID
+	FindApplicationByID       = findApplicationByID
+	FindJobRunByTwoPartKey    = findJobRunByTwoPartKey
+	JobRunResourceIDPartCount = jobRunResourceIDPartCount
 
 	ResourceApplication = resourceApplication
+	ResourceJobRun      = resourceJobRun
 )
@@ -0,0 +1,837 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	jobRunResourceIDPartCount = 2
+)
+
+// @SDKResource("aws_emrserverless_job_run", name="Job Run")
+// @Tags(identifierAttribute="arn")
+func resourceJobRun() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceJobRunCreate,
+		ReadWithoutTimeout:   resourceJobRunRead,
+		UpdateWithoutTimeout: resourceJobRunUpdate,
+		DeleteWithoutTimeout: resourceJobRunDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"configuration_overrides": {
+				Type:     schema.TypeList,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"application_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"classification": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									names.AttrProperties: {
+										Type:     schema.TypeMap,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"monitoring_configuration": {
+							Type:     schema.TypeList,
+							Optional: true,
+							Computed: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"cloudwatch_logging_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												names.AttrEnabled: {
+													Type:     schema.TypeBool,
+													Required: true,
+													ForceNew: true,
+												},
+												"encryption_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												names.AttrLogGroupName: {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+												"log_stream_name_prefix": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+									"s3_monitoring_configuration": {
+										Type:     schema.TypeList,
+										Optional: true,
+										Computed: true,
+										ForceNew: true,
+										MaxItems: 1,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"encryption_key_arn": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ForceNew:     true,
+													ValidateFunc: verify.ValidARN,
+												},
+												"log_uri": {
+													Type:     schema.TypeString,
+													Optional: true,
+													ForceNew: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrCreatedAt: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"ended_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrExecutionRoleARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"execution_timeout_minutes": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(0, 1000000),
+			},
+			"job_driver": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"hive": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							ForceNew:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"job_driver.0.hive", "job_driver.0.spark_submit"},
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"init_query_file": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									names.AttrParameters: {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+									"query": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+						"spark_submit": {
+							Type:     schema.TypeList,
+							Optional: true,
+							ForceNew: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"entry_point": {
+										Type:     schema.TypeString,
+										Required: true,
+										ForceNew: true,
+									},
+									"entry_point_arguments": {
+										Type:     schema.TypeList,
+										Optional: true,
+										ForceNew: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+									"spark_submit_parameters": {
+										Type:     schema.TypeString,
+										Optional: true,
+										ForceNew: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"job_run_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			"release_label": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"started_at": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"state_details": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"total_execution_duration_seconds": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"triggers": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				ForceNew: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"wait_for_completion": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  true,
+			},
+		},
+	}
+}
+
+func resourceJobRunCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	applicationID := d.Get("application_id").(string)
+
+	// A job run cannot be submitted to an application that is still being created.
+	application, err := findApplicationByID(ctx, conn, applicationID)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Application (%s): %s", applicationID, err)
+	}
+
+	if application.State == types.ApplicationStateCreating {
+		if _, err := waitApplicationCreated(ctx, conn, applicationID); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Serveless Application (%s) create: %s", applicationID, err)
+		}
+	}
+
+	input := &emrserverless.StartJobRunInput{
+		ApplicationId:    aws.String(applicationID),
+		ClientToken:      aws.String(id.UniqueId()),
+		ExecutionRoleArn: aws.String(d.Get(names.AttrExecutionRoleARN).(string)),
+		Tags:             getTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("configuration_overrides"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.ConfigurationOverrides = expandConfigurationOverrides(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("execution_timeout_minutes"); ok {
+		input.ExecutionTimeoutMinutes = aws.Int64(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("job_driver"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.JobDriver = expandJobDriver(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk(names.AttrName); ok {
+		input.Name = aws.String(v.(string))
+	}
+
+	output, err := conn.StartJobRun(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "starting EMR Serverless Job Run (%s): %s", applicationID, err)
+	}
+
+	jobRunID := aws.ToString(output.JobRunId)
+	id, err := flex.FlattenResourceId([]string{applicationID, jobRunID}, jobRunResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	d.SetId(id)
+
+	if d.Get("wait_for_completion").(bool) {
+		if _, err := waitJobRunSucceeded(ctx, conn, applicationID, jobRunID, d.Timeout(schema.TimeoutCreate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for EMR Serverless Job Run (%s) complete: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceJobRunRead(ctx, d, meta)...)
+}
+
+func resourceJobRunRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobRunResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, jobRunID := parts[0], parts[1]
+	jobRun, err := findJobRunByTwoPartKey(ctx, conn, applicationID, jobRunID)
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] EMR Serverless Job Run (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Job Run (%s): %s", d.Id(), err)
+	}
+
+	d.Set("application_id", jobRun.ApplicationId)
+	d.Set(names.AttrARN, jobRun.Arn)
+	if err := d.Set("configuration_overrides", flattenConfigurationOverrides(jobRun.ConfigurationOverrides)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting configuration_overrides: %s", err)
+	}
+	d.Set(names.AttrCreatedAt, aws.ToTime(jobRun.CreatedAt).Format(time.RFC3339))
+	if jobRun.EndedAt != nil {
+		d.Set("ended_at", aws.ToTime(jobRun.EndedAt).Format(time.RFC3339))
+	} else {
+		d.Set("ended_at", nil)
+	}
+	d.Set(names.AttrExecutionRoleARN, jobRun.ExecutionRole)
+	d.Set("execution_timeout_minutes", jobRun.ExecutionTimeoutMinutes)
+	if err := d.Set("job_driver", flattenJobDriver(jobRun.JobDriver)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting job_driver: %s", err)
+	}
+	d.Set("job_run_id", jobRun.JobRunId)
+	d.Set(names.AttrName, jobRun.Name)
+	d.Set("release_label", jobRun.ReleaseLabel)
+	if jobRun.StartedAt != nil {
+		d.Set("started_at", aws.ToTime(jobRun.StartedAt).Format(time.RFC3339))
+	} else {
+		d.Set("started_at", nil)
+	}
+	d.Set(names.AttrState, jobRun.State)
+	d.Set("state_details", jobRun.StateDetails)
+	d.Set("total_execution_duration_seconds", jobRun.TotalExecutionDurationSeconds)
+
+	setTagsOut(ctx, jobRun.Tags)
+
+	return diags
+}
+
+func resourceJobRunUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	// Tags only.
+	return resourceJobRunRead(ctx, d, meta)
+}
+
+func resourceJobRunDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	parts, err := flex.ExpandResourceId(d.Id(), jobRunResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	applicationID, jobRunID := parts[0], parts[1]
+	jobRun, err := findJobRunByTwoPartKey(ctx, conn, applicationID, jobRunID)
+
+	if tfresource.NotFound(err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Job Run (%s): %s", d.Id(), err)
+	}
+
+	// Job runs in a terminal state cannot be deleted; only active runs are cancelled.
+	if !slices.Contains(jobRunActiveStates(), jobRun.State) {
+		return diags
+	}
+
+	log.Printf("[INFO] Cancelling EMR Serverless Job Run: %s", d.Id())
+	_, err = conn.CancelJobRun(ctx, &emrserverless.CancelJobRunInput{
+		ApplicationId: aws.String(applicationID),
+		JobRunId:      aws.String(jobRunID),
+	})
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "cancelling EMR Serverless Job Run (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitJobRunCancelled(ctx, conn, applicationID, jobRunID, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for EMR Serverless Job Run (%s) cancel: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func jobRunActiveStates() []types.JobRunState {
+	return []types.JobRunState{
+		types.JobRunStateSubmitted,
+		types.JobRunStatePending,
+		types.JobRunStateScheduled,
+		types.JobRunStateRunning,
+		types.JobRunStateQueued,
+	}
+}
+
+func findJobRunByTwoPartKey(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string) (*types.JobRun, error) {
+	input := &emrserverless.GetJobRunInput{
+		ApplicationId: aws.String(applicationID),
+		JobRunId:      aws.String(jobRunID),
+	}
+
+	output, err := conn.GetJobRun(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.JobRun == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.JobRun, nil
+}
+
+func statusJobRun(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findJobRunByTwoPartKey(ctx, conn, applicationID, jobRunID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.State), nil
+	}
+}
+
+func waitJobRunSucceeded(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string, timeout time.Duration) (*types.JobRun, error) {
+	const (
+		minTimeout = 10 * time.Second
+		delay      = 30 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(jobRunActiveStates()...),
+		Target:     enum.Slice(types.JobRunStateSuccess),
+		Refresh:    statusJobRun(ctx, conn, applicationID, jobRunID),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobRun); ok {
+		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateChangeReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitJobRunCancelled(ctx context.Context, conn *emrserverless.Client, applicationID, jobRunID string, timeout time.Duration) (*types.JobRun, error) {
+	const (
+		minTimeout = 10 * time.Second
+		delay      = 10 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(append(jobRunActiveStates(), types.JobRunStateCancelling)...),
+		Target:     enum.Slice(types.JobRunStateCancelled, types.JobRunStateFailed, types.JobRunStateSuccess),
+		Refresh:    statusJobRun(ctx, conn, applicationID, jobRunID),
+		Timeout:    timeout,
+		MinTimeout: minTimeout,
+		Delay:      delay,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*types.JobRun); ok {
+		if stateChangeReason := output.StateDetails; stateChangeReason != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(stateChangeReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandJobDriver(tfMap map[string]any) types.JobDriver {
+	if tfMap == nil {
+		return nil
+	}
+
+	if v, ok := tfMap["hive"].([]any); ok && len(v) > 0 && v[0] != nil {
+		return &types.JobDriverMemberHive{
+			Value: expandHive(v[0].(map[string]any)),
+		}
+	}
+
+	if v, ok := tfMap["spark_submit"].([]any); ok && len(v) > 0 && v[0] != nil {
+		return &types.JobDriverMemberSparkSubmit{
+			Value: expandSparkSubmit(v[0].(map[string]any)),
+		}
+	}
+
+	return nil
+}
+
+func flattenJobDriver(apiObject types.JobDriver) []any {
+	tfMap := map[string]any{}
+
+	switch v := apiObject.(type) {
+	case *types.JobDriverMemberHive:
+		tfMap["hive"] = []any{flattenHive(&v.Value)}
+	case *types.JobDriverMemberSparkSubmit:
+		tfMap["spark_submit"] = []any{flattenSparkSubmit(&v.Value)}
+	default:
+		return nil
+	}
+
+	return []any{tfMap}
+}
+
+func expandHive(tfMap map[string]any) types.Hive {
+	apiObject := types.Hive{}
+
+	if v, ok := tfMap["init_query_file"].(string); ok && v != "" {
+		apiObject.InitQueryFile = aws.String(v)
+	}
+
+	if v, ok := tfMap[names.AttrParameters].(string); ok && v != "" {
+		apiObject.Parameters = aws.String(v)
+	}
+
+	if v, ok := tfMap["query"].(string); ok && v != "" {
+		apiObject.Query = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenHive(apiObject *types.Hive) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.InitQueryFile; v != nil {
+		tfMap["init_query_file"] = aws.ToString(v)
+	}
+
+	if v := apiObject.Parameters; v != nil {
+		tfMap[names.AttrParameters] = aws.ToString(v)
+	}
+
+	if v := apiObject.Query; v != nil {
+		tfMap["query"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandSparkSubmit(tfMap map[string]any) types.SparkSubmit {
+	apiObject := types.SparkSubmit{}
+
+	if v, ok := tfMap["entry_point"].(string); ok && v != "" {
+		apiObject.EntryPoint = aws.String(v)
+	}
+
+	if v, ok := tfMap["entry_point_arguments"].([]any); ok && len(v) > 0 {
+		apiObject.EntryPointArguments = flex.ExpandStringValueList(v)
+	}
+
+	if v, ok := tfMap["spark_submit_parameters"].(string); ok && v != "" {
+		apiObject.SparkSubmitParameters = aws.String(v)
+	}
+
+	return apiObject
+}
+
+func flattenSparkSubmit(apiObject *types.SparkSubmit) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.EntryPoint; v != nil {
+		tfMap["entry_point"] = aws.ToString(v)
+	}
+
+	if v := apiObject.EntryPointArguments; v != nil {
+		tfMap["entry_point_arguments"] = v
+	}
+
+	if v := apiObject.SparkSubmitParameters; v != nil {
+		tfMap["spark_submit_parameters"] = aws.ToString(v)
+	}
+
+	return tfMap
+}
+
+func expandConfigurationOverrides(tfMap map[string]any) *types.ConfigurationOverrides {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.ConfigurationOverrides{}
+
+	if v, ok := tfMap["application_configuration"].([]any); ok && len(v) > 0 {
+		apiObject.ApplicationConfiguration = expandConfigurations(v)
+	}
+
+	if v, ok := tfMap["monitoring_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		apiObject.MonitoringConfiguration = expandMonitoringConfiguration(v[0].(map[string]any))
+	}
+
+	return apiObject
+}
+
+func flattenConfigurationOverrides(apiObject *types.ConfigurationOverrides) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.ApplicationConfiguration; v != nil {
+		tfMap["application_configuration"] = flattenConfigurations(v)
+	}
+
+	if v := apiObject.MonitoringConfiguration; v != nil {
+		tfMap["monitoring_configuration"] = []any{flattenMonitoringConfiguration(v)}
+	}
+
+	return []any{tfMap}
+}
+
+func expandConfigurations(tfList []any) []types.Configuration {
+	var apiObjects []types.Configuration
+
+	for _, tfMapRaw := range tfList {
+		tfMap, ok := tfMapRaw.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		apiObject := types.Configuration{}
+
+		if v, ok := tfMap["classification"].(string); ok && v != "" {
+			apiObject.Classification = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrProperties].(map[string]any); ok && len(v) > 0 {
+			apiObject.Properties = flex.ExpandStringValueMap(v)
+		}
+
+		apiObjects = append(apiObjects, apiObject)
+	}
+
+	return apiObjects
+}
+
+func flattenConfigurations(apiObjects []types.Configuration) []any {
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{}
+
+		if v := apiObject.Classification; v != nil {
+			tfMap["classification"] = aws.ToString(v)
+		}
+
+		if v := apiObject.Properties; v != nil {
+			tfMap[names.AttrProperties] = v
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func expandMonitoringConfiguration(tfMap map[string]any) *types.MonitoringConfiguration {
+	if tfMap == nil {
+		return nil
+	}
+
+	apiObject := &types.MonitoringConfiguration{}
+
+	if v, ok := tfMap["cloudwatch_logging_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		config := &types.CloudWatchLoggingConfiguration{}
+
+		if v, ok := tfMap[names.AttrEnabled].(bool); ok {
+			config.Enabled = aws.Bool(v)
+		}
+
+		if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+			config.EncryptionKeyArn = aws.String(v)
+		}
+
+		if v, ok := tfMap[names.AttrLogGroupName].(string); ok && v != "" {
+			config.LogGroupName = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_stream_name_prefix"].(string); ok && v != "" {
+			config.LogStreamNamePrefix = aws.String(v)
+		}
+
+		apiObject.CloudWatchLoggingConfiguration = config
+	}
+
+	if v, ok := tfMap["s3_monitoring_configuration"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		config := &types.S3MonitoringConfiguration{}
+
+		if v, ok := tfMap["encryption_key_arn"].(string); ok && v != "" {
+			config.EncryptionKeyArn = aws.String(v)
+		}
+
+		if v, ok := tfMap["log_uri"].(string); ok && v != "" {
+			config.LogUri = aws.String(v)
+		}
+
+		apiObject.S3MonitoringConfiguration = config
+	}
+
+	return apiObject
+}
+
+func flattenMonitoringConfiguration(apiObject *types.MonitoringConfiguration) map[string]any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{}
+
+	if v := apiObject.CloudWatchLoggingConfiguration; v != nil {
+		tfMap["cloudwatch_logging_configuration"] = []any{map[string]any{
+			names.AttrEnabled:        aws.ToBool(v.Enabled),
+			"encryption_key_arn":     aws.ToString(v.EncryptionKeyArn),
+			names.AttrLogGroupName:   aws.ToString(v.LogGroupName),
+			"log_stream_name_prefix": aws.ToString(v.LogStreamNamePrefix),
+		}}
+	}
+
+	if v := apiObject.S3MonitoringConfiguration; v != nil {
+		tfMap["s3_monitoring_configuration"] = []any{map[string]any{
+			"encryption_key_arn": aws.ToString(v.EncryptionKeyArn),
+			"log_uri":            aws.ToString(v.LogUri),
+		}}
+	}
+
+	return tfMap
+}
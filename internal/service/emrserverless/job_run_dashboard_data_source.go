@@ -0,0 +1,108 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_emrserverless_job_run_dashboard", name="Job Run Dashboard")
+func dataSourceJobRunDashboard() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceJobRunDashboardRead,
+
+		Schema: map[string]*schema.Schema{
+			"access_system_profile_logs": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+			"application_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"attempt": {
+				Type:     schema.TypeInt,
+				Optional: true,
+			},
+			"job_run_id": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrURL: {
+				Type:      schema.TypeString,
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func dataSourceJobRunDashboardRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).EMRServerlessClient(ctx)
+
+	applicationID, jobRunID := d.Get("application_id").(string), d.Get("job_run_id").(string)
+	id, err := flex.FlattenResourceId([]string{applicationID, jobRunID}, jobRunResourceIDPartCount, false)
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	input := &emrserverless.GetDashboardForJobRunInput{
+		ApplicationId: aws.String(applicationID),
+		JobRunId:      aws.String(jobRunID),
+	}
+
+	if v, ok := d.GetOk("access_system_profile_logs"); ok {
+		input.AccessSystemProfileLogs = aws.Bool(v.(bool))
+	}
+
+	if v, ok := d.GetOk("attempt"); ok {
+		input.Attempt = aws.Int32(int32(v.(int)))
+	}
+
+	output, err := findDashboardForJobRun(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EMR Serverless Job Run (%s) dashboard: %s", id, err)
+	}
+
+	d.SetId(id)
+	d.Set(names.AttrURL, output.Url)
+
+	return diags
+}
+
+func findDashboardForJobRun(ctx context.Context, conn *emrserverless.Client, input *emrserverless.GetDashboardForJobRunInput) (*emrserverless.GetDashboardForJobRunOutput, error) {
+	output, err := conn.GetDashboardForJobRun(ctx, input)
+
+	if errs.IsA[*types.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Url == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
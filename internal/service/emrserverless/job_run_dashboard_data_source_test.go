@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRServerlessJobRunDashboardDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_emrserverless_job_run_dashboard.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunDashboardDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "application_id", "aws_emrserverless_job_run.test", "application_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "job_run_id", "aws_emrserverless_job_run.test", "job_run_id"),
+					resource.TestCheckResourceAttrSet(dataSourceName, names.AttrURL),
+				),
+			},
+		},
+	})
+}
+
+func testAccJobRunDashboardDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_basic(rName), `
+data "aws_emrserverless_job_run_dashboard" "test" {
+  application_id = aws_emrserverless_job_run.test.application_id
+  job_run_id     = aws_emrserverless_job_run.test.job_run_id
+}
+`)
+}
@@ -0,0 +1,233 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package emrserverless_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/emrserverless/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfemrserverless "github.com/hashicorp/terraform-provider-aws/internal/service/emrserverless"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEMRServerlessJobRun_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_emrserverless_application.test", names.AttrID),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "emr-serverless", regexache.MustCompile(`/applications/.+/jobruns/.+$`)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrExecutionRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "job_driver.0.spark_submit.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "job_run_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(types.JobRunStateSuccess)),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"triggers", "wait_for_completion"},
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessJobRun_noWait(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_noWait(rName, "1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, "wait_for_completion", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "triggers.%", "1"),
+				),
+			},
+			{
+				Config: testAccJobRunConfig_noWait(rName, "2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, "triggers.run", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEMRServerlessJobRun_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var jobRun types.JobRun
+	resourceName := "aws_emrserverless_job_run.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EMRServerlessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckApplicationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccJobRunConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccJobRunConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1Updated),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckJobRunExists(ctx, resourceName, &jobRun),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckJobRunExists(ctx context.Context, resourceName string, jobRun *types.JobRun) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		parts, err := flex.ExpandResourceId(rs.Primary.ID, tfemrserverless.JobRunResourceIDPartCount, false)
+		if err != nil {
+			return err
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).EMRServerlessClient(ctx)
+
+		output, err := tfemrserverless.FindJobRunByTwoPartKey(ctx, conn, parts[0], parts[1])
+		if err != nil {
+			return err
+		}
+
+		*jobRun = *output
+
+		return nil
+	}
+}
+
+func testAccJobRunConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_emrserverless_application" "test" {
+  name          = %[1]q
+  release_label = "emr-7.0.0"
+  type          = "spark"
+}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "emr-serverless.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccJobRunConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id     = aws_emrserverless_application.test.id
+  execution_role_arn = aws_iam_role.test.arn
+  name               = %[1]q
+
+  job_driver {
+    spark_submit {
+      entry_point             = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+      entry_point_arguments   = ["10"]
+      spark_submit_parameters = "--conf spark.executor.cores=1 --conf spark.executor.memory=4g --conf spark.driver.cores=1 --conf spark.driver.memory=4g --conf spark.executor.instances=1"
+    }
+  }
+}
+`, rName))
+}
+
+func testAccJobRunConfig_noWait(rName, trigger string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id      = aws_emrserverless_application.test.id
+  execution_role_arn  = aws_iam_role.test.arn
+  name                = %[1]q
+  wait_for_completion = false
+
+  job_driver {
+    spark_submit {
+      entry_point = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+    }
+  }
+
+  triggers = {
+    run = %[2]q
+  }
+}
+`, rName, trigger))
+}
+
+func testAccJobRunConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccJobRunConfig_base(rName), fmt.Sprintf(`
+resource "aws_emrserverless_job_run" "test" {
+  application_id      = aws_emrserverless_application.test.id
+  execution_role_arn  = aws_iam_role.test.arn
+  name                = %[1]q
+  wait_for_completion = false
+
+  job_driver {
+    spark_submit {
+      entry_point = "local:///usr/lib/spark/examples/src/main/python/pi.py"
+    }
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
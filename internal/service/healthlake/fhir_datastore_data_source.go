@@ -0,0 +1,106 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package healthlake
+
+import (
+	"context"
+	"fmt"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/healthlake/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_healthlake_fhir_datastore", name="FHIR Datastore")
+// @Tags(identifierAttribute="arn")
+func newFHIRDatastoreDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &fhirDatastoreDataSource{}, nil
+}
+
+type fhirDatastoreDataSource struct {
+	framework.DataSourceWithModel[fhirDatastoreDataSourceModel]
+}
+
+func (d *fhirDatastoreDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"datastore_endpoint": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_id": schema.StringAttribute{
+				Required: true,
+			},
+			"datastore_name": schema.StringAttribute{
+				Computed: true,
+			},
+			"datastore_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DatastoreStatus](),
+				Computed:   true,
+			},
+			"datastore_type_version": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FHIRVersion](),
+				Computed:   true,
+			},
+			"identity_provider_configuration": framework.DataSourceComputedListOfObjectAttribute[identityProviderConfigurationModel](ctx),
+			"preload_data_config":             framework.DataSourceComputedListOfObjectAttribute[preloadDataConfigModel](ctx),
+			"sse_configuration":               framework.DataSourceComputedListOfObjectAttribute[sseConfigurationModel](ctx),
+			names.AttrTags:                    tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+func (d *fhirDatastoreDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data fhirDatastoreDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().HealthLakeClient(ctx)
+
+	id := data.DatastoreID.ValueString()
+	datastore, err := findFHIRDatastoreByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading HealthLake FHIR Datastore (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, datastore, &data, fhirDatastoreFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type fhirDatastoreDataSourceModel struct {
+	framework.WithRegionModel
+	ARN                           types.String                                                        `tfsdk:"arn"`
+	CreatedAt                     timetypes.RFC3339                                                   `tfsdk:"created_at"`
+	DatastoreEndpoint             types.String                                                        `tfsdk:"datastore_endpoint"`
+	DatastoreID                   types.String                                                        `tfsdk:"datastore_id"`
+	DatastoreName                 types.String                                                        `tfsdk:"datastore_name"`
+	DatastoreStatus               fwtypes.StringEnum[awstypes.DatastoreStatus]                        `tfsdk:"datastore_status"`
+	DatastoreTypeVersion          fwtypes.StringEnum[awstypes.FHIRVersion]                            `tfsdk:"datastore_type_version"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationModel] `tfsdk:"identity_provider_configuration"`
+	PreloadDataConfig             fwtypes.ListNestedObjectValueOf[preloadDataConfigModel]             `tfsdk:"preload_data_config"`
+	SSEConfiguration              fwtypes.ListNestedObjectValueOf[sseConfigurationModel]              `tfsdk:"sse_configuration"`
+	Tags                          tftags.Map                                                          `tfsdk:"tags"`
+}
@@ -59,6 +59,8 @@ var (
 	FindUserPolicyAttachmentsByName     = findUserPolicyAttachmentsByName
 	FindVirtualMFADeviceBySerialNumber  = findVirtualMFADeviceBySerialNumber
 	SESSMTPPasswordFromSecretKeySigV4   = sesSMTPPasswordFromSecretKeySigV4
+	SplitPolicyDocument                 = splitPolicyDocument
+	UnusedServicesAndActions            = unusedServicesAndActions
 
 	RolePolicyParseID = rolePolicyParseID
 )
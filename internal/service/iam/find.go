@@ -123,3 +123,44 @@ func FindSigningCertificate(ctx context.Context, conn *iam.Client, userName, cer
 
 	return &cert, nil
 }
+
+func findServiceLastAccessedDetailsByJobID(ctx context.Context, conn *iam.Client, jobID string) (*iam.GetServiceLastAccessedDetailsOutput, error) {
+	input := &iam.GetServiceLastAccessedDetailsInput{
+		JobId: aws.String(jobID),
+	}
+
+	var output *iam.GetServiceLastAccessedDetailsOutput
+
+	for {
+		page, err := conn.GetServiceLastAccessedDetails(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		if page == nil {
+			return nil, tfresource.NewEmptyResultError(input)
+		}
+
+		if output == nil {
+			output = page
+		} else {
+			output.ServicesLastAccessed = append(output.ServicesLastAccessed, page.ServicesLastAccessed...)
+		}
+
+		if !page.IsTruncated {
+			break
+		}
+
+		input.Marker = page.Marker
+	}
+
+	return output, nil
+}
@@ -64,6 +64,11 @@ func dataSourcePolicyDocument() *schema.Resource {
 					Type:     schema.TypeString,
 					Computed: true,
 				},
+				"max_document_size": {
+					Type:         schema.TypeInt,
+					Optional:     true,
+					ValidateFunc: validation.IntAtLeast(1),
+				},
 				"minified_json": {
 					Type:     schema.TypeString,
 					Computed: true,
@@ -104,6 +109,13 @@ func dataSourcePolicyDocument() *schema.Resource {
 						ValidateFunc: validation.StringIsJSON,
 					},
 				},
+				"split_documents": {
+					Type:     schema.TypeList,
+					Computed: true,
+					Elem: &schema.Schema{
+						Type: schema.TypeString,
+					},
+				},
 				"statement": {
 					Type:     schema.TypeList,
 					Optional: true,
@@ -327,6 +339,15 @@ func dataSourcePolicyDocumentRead(ctx context.Context, d *schema.ResourceData, m
 
 	d.Set("minified_json", jsonMinString)
 
+	if v, ok := d.GetOk("max_document_size"); ok {
+		documents, err := splitPolicyDocument(mergedDoc, v.(int))
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "writing IAM Policy Document: splitting document: %s", err)
+		}
+
+		d.Set("split_documents", documents)
+	}
+
 	d.SetId(strconv.Itoa(create.StringHashcode(jsonString)))
 
 	return diags
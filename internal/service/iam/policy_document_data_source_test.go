@@ -419,6 +419,36 @@ func TestAccIAMPolicyDocumentDataSource_StatementPrincipalIdentifiers_multiplePr
 	})
 }
 
+func TestAccIAMPolicyDocumentDataSource_maxDocumentSize(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_iam_policy_document.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_maxDocumentSize(6144),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "split_documents.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "split_documents.0", `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"*"},{"Sid":"List","Effect":"Allow","Action":"s3:ListBucket","Resource":"*"}]}`),
+				),
+			},
+			{
+				Config: testAccPolicyDocumentDataSourceConfig_maxDocumentSize(150),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "split_documents.#", "2"),
+				),
+			},
+			{
+				Config:      testAccPolicyDocumentDataSourceConfig_maxDocumentSize(50),
+				ExpectError: regexache.MustCompile(`larger than the maximum document size`),
+			},
+		},
+	})
+}
+
 func TestAccIAMPolicyDocumentDataSource_version20081017(t *testing.T) {
 	ctx := acctest.Context(t)
 	resource.ParallelTest(t, resource.TestCase{
@@ -1341,6 +1371,30 @@ var testAccPolicyDocumentDuplicateBlankSidExpectedJSON = `{
   ]
 }`
 
+func testAccPolicyDocumentDataSourceConfig_maxDocumentSize(maxDocumentSize int) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  max_document_size = %[1]d
+
+  statement {
+    actions   = ["s3:GetObject"]
+    resources = ["*"]
+  }
+
+  statement {
+    actions   = ["s3:PutObject"]
+    resources = ["*"]
+  }
+
+  statement {
+    sid       = "List"
+    actions   = ["s3:ListBucket"]
+    resources = ["*"]
+  }
+}
+`, maxDocumentSize)
+}
+
 const testAccPolicyDocumentDataSourceConfig_version20081017 = `
 data "aws_iam_policy_document" "test" {
   version = "2008-10-17"
@@ -11,6 +11,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
 	"github.com/jmespath/go-jmespath"
 )
 
@@ -301,3 +302,159 @@ func IsValidPolicyAWSPrincipal(principal string) bool { // nosemgrep:ci.aws-in-f
 	}
 	return false
 }
+
+// splitPolicyDocument merges the document's statements and splits them into minified documents of at most maxSize characters.
+// IAM doesn't count white space towards policy size quotas.
+func splitPolicyDocument(doc *IAMPolicyDoc, maxSize int) ([]string, error) {
+	statements, err := mergePolicyStatements(doc, func(s *IAMPolicyStatement) *any { return &s.Actions })
+	if err != nil {
+		return nil, err
+	}
+	statements, err = mergePolicyStatements(&IAMPolicyDoc{Version: doc.Version, Statements: statements}, func(s *IAMPolicyStatement) *any { return &s.Resources })
+	if err != nil {
+		return nil, err
+	}
+
+	var documents []string
+	current := &IAMPolicyDoc{Version: doc.Version, Id: doc.Id}
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return nil, err
+	}
+
+	for len(statements) > 0 {
+		statement := statements[0]
+		statements = statements[1:]
+
+		candidate := &IAMPolicyDoc{Version: current.Version, Id: current.Id, Statements: append(slices.Clip(current.Statements), statement)}
+		candidateJSON, err := json.Marshal(candidate)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(candidateJSON) <= maxSize {
+			current, currentJSON = candidate, candidateJSON
+			continue
+		}
+
+		// Start a new document and try again.
+		if len(current.Statements) > 0 {
+			documents = append(documents, string(currentJSON))
+			current = &IAMPolicyDoc{Version: doc.Version, Id: doc.Id}
+			statements = slices.Insert(statements, 0, statement)
+			continue
+		}
+
+		// The statement doesn't fit in a document on its own.
+		first, second, ok := halvePolicyStatement(statement)
+		if !ok {
+			return nil, fmt.Errorf("statement (Sid: %q) is %d characters, which is larger than the maximum document size (%d)", statement.Sid, len(candidateJSON), maxSize)
+		}
+		statements = slices.Insert(statements, 0, first, second)
+	}
+
+	if len(current.Statements) > 0 || len(documents) == 0 {
+		documents = append(documents, string(currentJSON))
+	}
+
+	return documents, nil
+}
+
+// mergePolicyStatements combines statements that are equivalent except for the field returned by fieldFunc.
+// Statements with a Sid are not combined.
+func mergePolicyStatements(doc *IAMPolicyDoc, fieldFunc func(*IAMPolicyStatement) *any) ([]*IAMPolicyStatement, error) {
+	type group struct {
+		statement *IAMPolicyStatement
+		key       string
+		values    []string
+	}
+	var groups []*group
+
+	for _, statement := range doc.Statements {
+		values, ok := policyStatementStringList(*fieldFunc(statement))
+
+		if statement.Sid != "" || !ok || len(values) == 0 {
+			groups = append(groups, &group{statement: statement})
+			continue
+		}
+
+		// Compare the statements without the field being merged.
+		other := *statement
+		*fieldFunc(&other) = nil
+		key, err := json.Marshal(&IAMPolicyDoc{Version: doc.Version, Statements: []*IAMPolicyStatement{&other}})
+		if err != nil {
+			return nil, err
+		}
+
+		i := slices.IndexFunc(groups, func(g *group) bool {
+			return g.key != "" && verify.PolicyStringsEquivalent(g.key, string(key))
+		})
+		if i == -1 {
+			groups = append(groups, &group{statement: &other, key: string(key), values: values})
+			continue
+		}
+
+		groups[i].values = append(groups[i].values, values...)
+	}
+
+	statements := make([]*IAMPolicyStatement, 0, len(groups))
+	for _, g := range groups {
+		if g.key != "" {
+			values := slices.Compact(slices.Sorted(slices.Values(g.values)))
+			if len(values) == 1 {
+				*fieldFunc(g.statement) = values[0]
+			} else {
+				slices.Reverse(values)
+				*fieldFunc(g.statement) = values
+			}
+		}
+		statements = append(statements, g.statement)
+	}
+
+	return statements, nil
+}
+
+// halvePolicyStatement splits a statement's actions, or failing that its resources, between two statements.
+// Together the two statements allow or deny the same requests as the original.
+func halvePolicyStatement(statement *IAMPolicyStatement) (*IAMPolicyStatement, *IAMPolicyStatement, bool) {
+	for _, fieldFunc := range []func(*IAMPolicyStatement) *any{
+		func(s *IAMPolicyStatement) *any { return &s.Actions },
+		func(s *IAMPolicyStatement) *any { return &s.Resources },
+	} {
+		values, ok := policyStatementStringList(*fieldFunc(statement))
+		if !ok || len(values) < 2 {
+			continue
+		}
+
+		first, second := *statement, *statement
+		*fieldFunc(&first) = values[:len(values)/2]
+		*fieldFunc(&second) = values[len(values)/2:]
+
+		return &first, &second, true
+	}
+
+	return nil, nil, false
+}
+
+func policyStatementStringList(v any) ([]string, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, true
+	case string:
+		return []string{v}, true
+	case []string:
+		return v, true
+	case []any:
+		values := make([]string, 0, len(v))
+		for _, v := range v {
+			s, ok := v.(string)
+			if !ok {
+				return nil, false
+			}
+			values = append(values, s)
+		}
+		return values, true
+	}
+
+	return nil, false
+}
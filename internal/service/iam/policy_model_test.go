@@ -428,3 +428,165 @@ func TestPolicyUnmarshalServicePrincipalOrder(t *testing.T) {
 		t.Fatalf("should be equal, but was:\n%#v\nVS\n%#v\n", data1, data2)
 	}
 }
+
+func TestSplitPolicyDocument(t *testing.T) {
+	t.Parallel()
+
+	testcases := map[string]struct {
+		doc       string
+		maxSize   int
+		want      []string
+		wantCount int
+		wantErr   bool
+	}{
+		"merge_actions": {
+			doc: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::bucket/*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": ["s3:PutObject", "s3:GetObject"],
+      "Resource": "arn:aws:s3:::bucket/*"
+    }
+  ]
+}`, // lintignore:AWSAT005
+			maxSize: 6144,
+			want: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":["s3:PutObject","s3:GetObject"],"Resource":"arn:aws:s3:::bucket/*"}]}`, // lintignore:AWSAT005
+			},
+		},
+		"merge_resources": {
+			doc: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::bucket1/*"
+    },
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "arn:aws:s3:::bucket2/*"
+    }
+  ]
+}`, // lintignore:AWSAT005
+			maxSize: 6144,
+			want: []string{
+				`{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"s3:GetObject","Resource":["arn:aws:s3:::bucket2/*","arn:aws:s3:::bucket1/*"]}]}`, // lintignore:AWSAT005
+			},
+		},
+		"no_merge_sid": {
+			doc: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Get",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Put",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "*"
+    }
+  ]
+}`,
+			maxSize: 6144,
+			want: []string{
+				`{"Version":"2012-10-17","Statement":[{"Sid":"Get","Effect":"Allow","Action":"s3:GetObject","Resource":"*"},{"Sid":"Put","Effect":"Allow","Action":"s3:PutObject","Resource":"*"}]}`,
+			},
+		},
+		"split_statements": {
+			doc: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Sid": "Get",
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    },
+    {
+      "Sid": "Put",
+      "Effect": "Allow",
+      "Action": "s3:PutObject",
+      "Resource": "*"
+    }
+  ]
+}`,
+			maxSize:   120,
+			wantCount: 2,
+		},
+		"split_actions": {
+			doc: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": ["s3:GetObject", "s3:PutObject", "s3:DeleteObject", "s3:ListBucket"],
+      "Resource": "*"
+    }
+  ]
+}`,
+			maxSize:   120,
+			wantCount: 2,
+		},
+		"statement_too_large": {
+			doc: `{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Action": "s3:GetObject",
+      "Resource": "*"
+    }
+  ]
+}`,
+			maxSize: 50,
+			wantErr: true,
+		},
+	}
+
+	for name, tc := range testcases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var doc tfiam.IAMPolicyDoc
+			if err := json.Unmarshal([]byte(tc.doc), &doc); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := tfiam.SplitPolicyDocument(&doc, tc.maxSize)
+
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error, got none")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+
+			if tc.want != nil && !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("got %v, want %v", got, tc.want)
+			}
+			if tc.wantCount != 0 && len(got) != tc.wantCount {
+				t.Errorf("got %d documents, want %d", len(got), tc.wantCount)
+			}
+			for _, v := range got {
+				if len(v) > tc.maxSize {
+					t.Errorf("document %s is %d characters, larger than %d", v, len(v), tc.maxSize)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_iam_service_last_accessed_details", name="Service Last Accessed Details")
+func dataSourceServiceLastAccessedDetails() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceServiceLastAccessedDetailsRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"granularity": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.AccessAdvisorUsageGranularityTypeActionLevel,
+				ValidateDiagFunc: enum.Validate[awstypes.AccessAdvisorUsageGranularityType](),
+			},
+			"job_completion_date": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"job_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"services_last_accessed": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"last_authenticated": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_entity": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"last_authenticated_region": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrServiceName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"service_namespace": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"total_authenticated_entities": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+						"tracked_actions_last_accessed": {
+							Type:     schema.TypeList,
+							Computed: true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"action_name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_entity": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_region": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"last_accessed_time": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"unused_actions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"unused_days": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      90,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"unused_services": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func dataSourceServiceLastAccessedDetailsRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	arn := d.Get(names.AttrARN).(string)
+	input := iam.GenerateServiceLastAccessedDetailsInput{
+		Arn:         aws.String(arn),
+		Granularity: awstypes.AccessAdvisorUsageGranularityType(d.Get("granularity").(string)),
+	}
+
+	output, err := conn.GenerateServiceLastAccessedDetails(ctx, &input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "generating IAM Service Last Accessed Details (%s): %s", arn, err)
+	}
+
+	jobID := aws.ToString(output.JobId)
+	details, err := waitServiceLastAccessedDetailsJobCompleted(ctx, conn, jobID, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for IAM Service Last Accessed Details (%s) job (%s) complete: %s", arn, jobID, err)
+	}
+
+	// Access Advisor data is retained for 400 days, so services and actions without a last accessed time have not been used in that period.
+	cutoff := time.Now().AddDate(0, 0, -d.Get("unused_days").(int))
+	unusedServices, unusedActions := unusedServicesAndActions(details.ServicesLastAccessed, cutoff)
+
+	d.SetId(jobID)
+	d.Set(names.AttrARN, arn)
+	if details.JobCompletionDate != nil {
+		d.Set("job_completion_date", details.JobCompletionDate.Format(time.RFC3339))
+	} else {
+		d.Set("job_completion_date", nil)
+	}
+	d.Set("job_id", jobID)
+	if err := d.Set("services_last_accessed", flattenServicesLastAccessed(details.ServicesLastAccessed)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting services_last_accessed: %s", err)
+	}
+	d.Set("unused_actions", unusedActions)
+	d.Set("unused_services", unusedServices)
+
+	return diags
+}
+
+// unusedServicesAndActions returns the namespaces of the services, and the names (in "service:Action" form) of the tracked actions,
+// that have not been accessed since the cutoff time.
+func unusedServicesAndActions(apiObjects []awstypes.ServiceLastAccessed, cutoff time.Time) ([]string, []string) {
+	unusedServices, unusedActions := []string{}, []string{}
+
+	for _, apiObject := range apiObjects {
+		namespace := aws.ToString(apiObject.ServiceNamespace)
+
+		if v := apiObject.LastAuthenticated; v == nil || v.Before(cutoff) {
+			unusedServices = append(unusedServices, namespace)
+		}
+
+		for _, v := range apiObject.TrackedActionsLastAccessed {
+			if v.LastAccessedTime == nil || v.LastAccessedTime.Before(cutoff) {
+				unusedActions = append(unusedActions, namespace+":"+aws.ToString(v.ActionName))
+			}
+		}
+	}
+
+	return unusedServices, unusedActions
+}
+
+func flattenServicesLastAccessed(apiObjects []awstypes.ServiceLastAccessed) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"last_authenticated_entity":     aws.ToString(apiObject.LastAuthenticatedEntity),
+			"last_authenticated_region":     aws.ToString(apiObject.LastAuthenticatedRegion),
+			names.AttrServiceName:           aws.ToString(apiObject.ServiceName),
+			"service_namespace":             aws.ToString(apiObject.ServiceNamespace),
+			"total_authenticated_entities":  aws.ToInt32(apiObject.TotalAuthenticatedEntities),
+			"tracked_actions_last_accessed": flattenTrackedActionsLastAccessed(apiObject.TrackedActionsLastAccessed),
+		}
+
+		if v := apiObject.LastAuthenticated; v != nil {
+			tfMap["last_authenticated"] = v.Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenTrackedActionsLastAccessed(apiObjects []awstypes.TrackedActionLastAccessed) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]any{
+			"action_name":          aws.ToString(apiObject.ActionName),
+			"last_accessed_entity": aws.ToString(apiObject.LastAccessedEntity),
+			"last_accessed_region": aws.ToString(apiObject.LastAccessedRegion),
+		}
+
+		if v := apiObject.LastAccessedTime; v != nil {
+			tfMap["last_accessed_time"] = v.Format(time.RFC3339)
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
@@ -0,0 +1,163 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfiam "github.com/hashicorp/terraform-provider-aws/internal/service/iam"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestUnusedServicesAndActions(t *testing.T) {
+	t.Parallel()
+
+	cutoff := time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC)
+	before, after := cutoff.Add(-time.Hour), cutoff.Add(time.Hour)
+
+	services := []awstypes.ServiceLastAccessed{
+		{
+			ServiceNamespace:  aws.String("s3"),
+			LastAuthenticated: aws.Time(after),
+			TrackedActionsLastAccessed: []awstypes.TrackedActionLastAccessed{
+				{ActionName: aws.String("GetObject"), LastAccessedTime: aws.Time(after)},
+				{ActionName: aws.String("PutObject"), LastAccessedTime: aws.Time(before)},
+				{ActionName: aws.String("DeleteObject")},
+			},
+		},
+		{
+			ServiceNamespace:  aws.String("ec2"),
+			LastAuthenticated: aws.Time(before),
+		},
+		{
+			ServiceNamespace: aws.String("sqs"),
+		},
+	}
+
+	gotServices, gotActions := tfiam.UnusedServicesAndActions(services, cutoff)
+
+	if diff := cmp.Diff(gotServices, []string{"ec2", "sqs"}); diff != "" {
+		t.Errorf("unexpected unused services diff (+wanted, -got): %s", diff)
+	}
+
+	if diff := cmp.Diff(gotActions, []string{"s3:PutObject", "s3:DeleteObject"}); diff != "" {
+		t.Errorf("unexpected unused actions diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestAccIAMServiceLastAccessedDetailsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_service_last_accessed_details.test"
+	resourceName := "aws_iam_role.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLastAccessedDetailsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "ACTION_LEVEL"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_completion_date"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "job_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.service_namespace", "s3"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_services.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_services.0", "s3"),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "unused_actions.*", "s3:GetObject"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIAMServiceLastAccessedDetailsDataSource_serviceLevel(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_iam_service_last_accessed_details.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.IAMServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccServiceLastAccessedDetailsDataSourceConfig_serviceLevel(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "granularity", "SERVICE_LEVEL"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "services_last_accessed.0.tracked_actions_last_accessed.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_actions.#", "0"),
+					resource.TestCheckResourceAttr(dataSourceName, "unused_services.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccServiceLastAccessedDetailsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "ec2.amazonaws.com"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = ["s3:GetObject", "s3:PutObject"]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccServiceLastAccessedDetailsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccServiceLastAccessedDetailsDataSourceConfig_base(rName), `
+data "aws_iam_service_last_accessed_details" "test" {
+  arn         = aws_iam_role.test.arn
+  unused_days = 30
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
+
+func testAccServiceLastAccessedDetailsDataSourceConfig_serviceLevel(rName string) string {
+	return acctest.ConfigCompose(testAccServiceLastAccessedDetailsDataSourceConfig_base(rName), `
+data "aws_iam_service_last_accessed_details" "test" {
+  arn         = aws_iam_role.test.arn
+  granularity = "SERVICE_LEVEL"
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`)
+}
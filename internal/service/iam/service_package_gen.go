@@ -153,6 +153,12 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.Service
 			Name:     "Server Certificate",
 			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
 		},
+		{
+			Factory:  dataSourceServiceLastAccessedDetails,
+			TypeName: "aws_iam_service_last_accessed_details",
+			Name:     "Service Last Accessed Details",
+			Region:   unique.Make(inttypes.ResourceRegionDisabled()),
+		},
 		{
 			Factory:  dataSourceSessionContext,
 			TypeName: "aws_iam_session_context",
@@ -0,0 +1,242 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package invoicing_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/invoicing"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfinvoicing "github.com/hashicorp/terraform-provider-aws/internal/service/invoicing"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccInvoicingInvoiceUnit_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_invoicing_invoice_unit.test"
+	var v invoicing.GetInvoiceUnitOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.InvoicingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInvoiceUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvoiceUnitConfig_basic(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvoiceUnitExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "first"),
+					resource.TestCheckResourceAttrPair(resourceName, "invoice_receiver", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttrSet(resourceName, "last_modified"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rule.0.linked_accounts.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "rule.0.linked_accounts.*", "data.aws_organizations_organization.test", "non_master_accounts.0.id"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttr(resourceName, "tax_inheritance_disabled", acctest.CtFalse),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrARN),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrARN,
+			},
+			{
+				Config: testAccInvoiceUnitConfig_basic(rName, "second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvoiceUnitExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "second"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccInvoicingInvoiceUnit_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_invoicing_invoice_unit.test"
+	var v invoicing.GetInvoiceUnitOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.InvoicingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInvoiceUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvoiceUnitConfig_basic(rName, "first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvoiceUnitExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfinvoicing.ResourceInvoiceUnit, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccInvoicingInvoiceUnit_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_invoicing_invoice_unit.test"
+	var v invoicing.GetInvoiceUnitOutput
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.InvoicingServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckInvoiceUnitDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccInvoiceUnitConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvoiceUnitExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccInvoiceUnitConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1Updated, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvoiceUnitExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1Updated),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				Config: testAccInvoiceUnitConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckInvoiceUnitExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckInvoiceUnitExists(ctx context.Context, n string, v *invoicing.GetInvoiceUnitOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).InvoicingClient(ctx)
+
+		output, err := tfinvoicing.FindInvoiceUnitByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckInvoiceUnitDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).InvoicingClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_invoicing_invoice_unit" {
+				continue
+			}
+
+			_, err := tfinvoicing.FindInvoiceUnitByARN(ctx, conn, rs.Primary.Attributes[names.AttrARN])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Invoicing Invoice Unit %s still exists", rs.Primary.Attributes[names.AttrARN])
+		}
+
+		return nil
+	}
+}
+
+const testAccInvoiceUnitConfig_base = `
+data "aws_caller_identity" "current" {}
+
+data "aws_organizations_organization" "test" {}
+`
+
+func testAccInvoiceUnitConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccInvoiceUnitConfig_base, fmt.Sprintf(`
+resource "aws_invoicing_invoice_unit" "test" {
+  name             = %[1]q
+  description      = %[2]q
+  invoice_receiver = data.aws_caller_identity.current.account_id
+
+  rule {
+    linked_accounts = [data.aws_organizations_organization.test.non_master_accounts[0].id]
+  }
+}
+`, rName, description))
+}
+
+func testAccInvoiceUnitConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccInvoiceUnitConfig_base, fmt.Sprintf(`
+resource "aws_invoicing_invoice_unit" "test" {
+  name             = %[1]q
+  invoice_receiver = data.aws_caller_identity.current.account_id
+
+  rule {
+    linked_accounts = [data.aws_organizations_organization.test.non_master_accounts[0].id]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1))
+}
+
+func testAccInvoiceUnitConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccInvoiceUnitConfig_base, fmt.Sprintf(`
+resource "aws_invoicing_invoice_unit" "test" {
+  name             = %[1]q
+  invoice_receiver = data.aws_caller_identity.current.account_id
+
+  rule {
+    linked_accounts = [data.aws_organizations_organization.test.non_master_accounts[0].id]
+  }
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -5,7 +5,17 @@ package kinesisvideo
 
 // Exports for use in tests only.
 var (
-	ResourceStream = resourceStream
+	ResourceSignalingChannel                          = resourceSignalingChannel
+	ResourceSignalingChannelMediaStorageConfiguration = resourceSignalingChannelMediaStorageConfiguration
+	ResourceStream                                    = resourceStream
+	ResourceStreamEdgeConfiguration                   = resourceStreamEdgeConfiguration
+	ResourceStreamImageGenerationConfiguration        = resourceStreamImageGenerationConfiguration
+	ResourceStreamNotificationConfiguration           = resourceStreamNotificationConfiguration
 
-	FindStreamByARN = findStreamByARN
+	FindSignalingChannelByARN                          = findSignalingChannelByARN
+	FindSignalingChannelMediaStorageConfigurationByARN = findSignalingChannelMediaStorageConfigurationByARN
+	FindStreamByARN                                    = findStreamByARN
+	FindStreamEdgeConfigurationByARN                   = findStreamEdgeConfigurationByARN
+	FindStreamImageGenerationConfigurationByARN        = findStreamImageGenerationConfigurationByARN
+	FindStreamNotificationConfigurationByARN           = findStreamNotificationConfigurationByARN
 )
@@ -1,8 +1,10 @@
 // Copyright (c) HashiCorp, Inc.
 // SPDX-License-Identifier: MPL-2.0
 
-//go:generate go run ../../generate/listpages/main.go -ListOps=ListTagsForStream
-//go:generate go run ../../generate/tags/main.go -KVTValues -ListTags -ListTagsOpPaginated -ListTagsOpPaginatorCustom -ListTagsOp=ListTagsForStream -ListTagsInIDElem=StreamARN -ServiceTagsMap -TagOp=TagStream -TagInIDElem=StreamARN -UntagOp=UntagStream -UntagInTagsElem=TagKeyList -UpdateTags
+//go:generate go run ../../generate/listpages/main.go -ListOps=ListTagsForResource,ListTagsForStream
+//go:generate go run ../../generate/tags/main.go -KVTValues -ListTags -ListTagsFunc=listStreamTags -ListTagsOpPaginated -ListTagsOpPaginatorCustom -ListTagsOp=ListTagsForStream -ListTagsInIDElem=StreamARN -ServiceTagsMap -TagOp=TagStream -TagInIDElem=StreamARN -UntagOp=UntagStream -UntagInTagsElem=TagKeyList -UpdateTags -UpdateTagsFunc=updateStreamTags
+//go:generate go run ../../generate/tags/main.go -KVTValues -ListTags -ListTagsFunc=listSignalingChannelTags -ListTagsOpPaginated -ListTagsOpPaginatorCustom -ListTagsInIDElem=ResourceARN -ServiceTagsMap -TagsFunc=svcSignalingChannelTagsMap -KeyValueTagsFunc=keyValueTagsFromSignalingChannelTagsMap -GetTagsInFunc=getSignalingChannelTagsMapIn -SetTagsOutFunc=setSignalingChannelTagsMapOut -- signaling_channel_list_tags_gen.go
+//go:generate go run ../../generate/tags/main.go -ServiceTagsSlice -TagsFunc=svcSignalingChannelTags -KeyValueTagsFunc=keyValueTagsFromSignalingChannelTags -GetTagsInFunc=getSignalingChannelTagsIn -SetTagsOutFunc=setSignalingChannelTagsOut -TagInIDElem=ResourceARN -UntagInTagsElem=TagKeyList -UpdateTags -UpdateTagsFunc=updateSignalingChannelTags -- signaling_channel_tags_gen.go
 //go:generate go run ../../generate/servicepackage/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
@@ -27,7 +27,14 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*inttypes.ServicePackageSDKDataSource {
-	return []*inttypes.ServicePackageSDKDataSource{}
+	return []*inttypes.ServicePackageSDKDataSource{
+		{
+			Factory:  dataSourceSignalingChannelEndpoint,
+			TypeName: "aws_kinesis_video_signaling_channel_endpoint",
+			Name:     "Signaling Channel Endpoint",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+	}
 }
 
 func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePackageSDKResource {
@@ -38,9 +45,44 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*inttypes.ServicePa
 			Name:     "Stream",
 			Tags: unique.Make(inttypes.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrID,
+				ResourceType:        "Stream",
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceSignalingChannel,
+			TypeName: "aws_kinesis_video_signaling_channel",
+			Name:     "Signaling Channel",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrID,
+				ResourceType:        "SignalingChannel",
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  resourceSignalingChannelMediaStorageConfiguration,
+			TypeName: "aws_kinesis_video_signaling_channel_media_storage_configuration",
+			Name:     "Signaling Channel Media Storage Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceStreamEdgeConfiguration,
+			TypeName: "aws_kinesis_video_stream_edge_configuration",
+			Name:     "Stream Edge Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceStreamImageGenerationConfiguration,
+			TypeName: "aws_kinesis_video_stream_image_generation_configuration",
+			Name:     "Stream Image Generation Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  resourceStreamNotificationConfiguration,
+			TypeName: "aws_kinesis_video_stream_notification_configuration",
+			Name:     "Stream Notification Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
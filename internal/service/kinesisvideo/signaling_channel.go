@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kinesis_video_signaling_channel", name="Signaling Channel")
+// @Tags(identifierAttribute="id", resourceType="SignalingChannel")
+func resourceSignalingChannel() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSignalingChannelCreate,
+		ReadWithoutTimeout:   resourceSignalingChannelRead,
+		UpdateWithoutTimeout: resourceSignalingChannelUpdate,
+		DeleteWithoutTimeout: resourceSignalingChannelDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(5 * time.Minute),
+			Update: schema.DefaultTimeout(5 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"channel_type": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Default:          awstypes.ChannelTypeSingleMaster,
+				ValidateDiagFunc: enum.Validate[awstypes.ChannelType](),
+			},
+			names.AttrCreationTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"message_ttl_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntBetween(5, 120),
+			},
+			names.AttrName: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+				ValidateFunc: validation.All(
+					validation.StringLenBetween(1, 256),
+					validation.StringMatch(regexache.MustCompile(`^[0-9A-Za-z_.-]+$`), "must only include alphanumeric, underscore, period, or hyphen characters"),
+				),
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			names.AttrVersion: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func resourceSignalingChannelCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	input := &kinesisvideo.CreateSignalingChannelInput{
+		ChannelName: aws.String(name),
+		ChannelType: awstypes.ChannelType(d.Get("channel_type").(string)),
+		Tags:        getSignalingChannelTagsIn(ctx),
+	}
+
+	if v, ok := d.GetOk("message_ttl_seconds"); ok {
+		input.SingleMasterConfiguration = &awstypes.SingleMasterConfiguration{
+			MessageTtlSeconds: aws.Int32(int32(v.(int))),
+		}
+	}
+
+	output, err := conn.CreateSignalingChannel(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "creating Kinesis Video Signaling Channel (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(output.ChannelARN))
+
+	if _, err := waitSignalingChannelCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Video Signaling Channel (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceSignalingChannelRead(ctx, d, meta)...)
+}
+
+func resourceSignalingChannelRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	channel, err := findSignalingChannelByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Signaling Channel (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Video Signaling Channel (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, channel.ChannelARN)
+	d.Set("channel_type", channel.ChannelType)
+	d.Set(names.AttrCreationTime, channel.CreationTime.Format(time.RFC3339))
+	if v := channel.SingleMasterConfiguration; v != nil {
+		d.Set("message_ttl_seconds", v.MessageTtlSeconds)
+	} else {
+		d.Set("message_ttl_seconds", nil)
+	}
+	d.Set(names.AttrName, channel.ChannelName)
+	d.Set(names.AttrVersion, channel.Version)
+
+	return diags
+}
+
+func resourceSignalingChannelUpdate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	if d.HasChangesExcept(names.AttrTags, names.AttrTagsAll) {
+		input := &kinesisvideo.UpdateSignalingChannelInput{
+			ChannelARN:     aws.String(d.Id()),
+			CurrentVersion: aws.String(d.Get(names.AttrVersion).(string)),
+			SingleMasterConfiguration: &awstypes.SingleMasterConfiguration{
+				MessageTtlSeconds: aws.Int32(int32(d.Get("message_ttl_seconds").(int))),
+			},
+		}
+
+		_, err := conn.UpdateSignalingChannel(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating Kinesis Video Signaling Channel (%s): %s", d.Id(), err)
+		}
+
+		if _, err := waitSignalingChannelUpdated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutUpdate)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Video Signaling Channel (%s) update: %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceSignalingChannelRead(ctx, d, meta)...)
+}
+
+func resourceSignalingChannelDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Kinesis Video Signaling Channel: %s", d.Id())
+	_, err := conn.DeleteSignalingChannel(ctx, &kinesisvideo.DeleteSignalingChannelInput{
+		ChannelARN:     aws.String(d.Id()),
+		CurrentVersion: aws.String(d.Get(names.AttrVersion).(string)),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Video Signaling Channel (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitSignalingChannelDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Video Signaling Channel (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSignalingChannelByARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.ChannelInfo, error) {
+	input := &kinesisvideo.DescribeSignalingChannelInput{
+		ChannelARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeSignalingChannel(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ChannelInfo == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ChannelInfo, nil
+}
+
+func statusSignalingChannel(ctx context.Context, conn *kinesisvideo.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findSignalingChannelByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.ChannelStatus), nil
+	}
+}
+
+func waitSignalingChannelCreated(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.ChannelInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusCreating),
+		Target:     enum.Slice(awstypes.StatusActive),
+		Refresh:    statusSignalingChannel(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSignalingChannelUpdated(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.ChannelInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusUpdating),
+		Target:     enum.Slice(awstypes.StatusActive),
+		Refresh:    statusSignalingChannel(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitSignalingChannelDeleted(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*awstypes.ChannelInfo, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.StatusDeleting),
+		Target:     []string{},
+		Refresh:    statusSignalingChannel(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 3 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ChannelInfo); ok {
+		return output, err
+	}
+
+	return nil, err
+}
@@ -0,0 +1,107 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_kinesis_video_signaling_channel_endpoint", name="Signaling Channel Endpoint")
+func dataSourceSignalingChannelEndpoint() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceSignalingChannelEndpointRead,
+
+		Schema: map[string]*schema.Schema{
+			"channel_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"protocols": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type:             schema.TypeString,
+					ValidateDiagFunc: enum.Validate[awstypes.ChannelProtocol](),
+				},
+			},
+			"resource_endpoint_list": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrProtocol: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"resource_endpoint": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrRole: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ChannelRoleMaster,
+				ValidateDiagFunc: enum.Validate[awstypes.ChannelRole](),
+			},
+		},
+	}
+}
+
+func dataSourceSignalingChannelEndpointRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	channelARN := d.Get("channel_arn").(string)
+	input := &kinesisvideo.GetSignalingChannelEndpointInput{
+		ChannelARN: aws.String(channelARN),
+		SingleMasterChannelEndpointConfiguration: &awstypes.SingleMasterChannelEndpointConfiguration{
+			Role: awstypes.ChannelRole(d.Get(names.AttrRole).(string)),
+		},
+	}
+
+	if v, ok := d.GetOk("protocols"); ok && v.(*schema.Set).Len() > 0 {
+		input.SingleMasterChannelEndpointConfiguration.Protocols = flex.ExpandStringyValueSet[awstypes.ChannelProtocol](v.(*schema.Set))
+	}
+
+	output, err := conn.GetSignalingChannelEndpoint(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Video Signaling Channel (%s) endpoint: %s", channelARN, err)
+	}
+
+	d.SetId(channelARN)
+
+	var protocols []string
+	tfList := make([]any, 0, len(output.ResourceEndpointList))
+	for _, v := range output.ResourceEndpointList {
+		protocols = append(protocols, string(v.Protocol))
+		tfList = append(tfList, map[string]any{
+			names.AttrProtocol:  v.Protocol,
+			"resource_endpoint": aws.ToString(v.ResourceEndpoint),
+		})
+	}
+	d.Set("protocols", protocols)
+	if err := d.Set("resource_endpoint_list", tfList); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting resource_endpoint_list: %s", err)
+	}
+
+	return diags
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoSignalingChannelEndpointDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_kinesis_video_signaling_channel_endpoint.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelEndpointDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "protocols.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "resource_endpoint_list.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "resource_endpoint_list.0.resource_endpoint"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrRole, "MASTER"),
+				),
+			},
+		},
+	})
+}
+
+func testAccSignalingChannelEndpointDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_signaling_channel" "test" {
+  name = %[1]q
+}
+
+data "aws_kinesis_video_signaling_channel_endpoint" "test" {
+  channel_arn = aws_kinesis_video_signaling_channel.test.arn
+  protocols   = ["HTTPS", "WSS"]
+}
+`, rName)
+}
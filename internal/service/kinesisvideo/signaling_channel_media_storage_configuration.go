@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kinesis_video_signaling_channel_media_storage_configuration", name="Signaling Channel Media Storage Configuration")
+func resourceSignalingChannelMediaStorageConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceSignalingChannelMediaStorageConfigurationPut,
+		ReadWithoutTimeout:   resourceSignalingChannelMediaStorageConfigurationRead,
+		UpdateWithoutTimeout: resourceSignalingChannelMediaStorageConfigurationPut,
+		DeleteWithoutTimeout: resourceSignalingChannelMediaStorageConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"channel_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.MediaStorageConfigurationStatusEnabled,
+				ValidateDiagFunc: enum.Validate[awstypes.MediaStorageConfigurationStatus](),
+			},
+			names.AttrStreamARN: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceSignalingChannelMediaStorageConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	channelARN := d.Get("channel_arn").(string)
+	input := &kinesisvideo.UpdateMediaStorageConfigurationInput{
+		ChannelARN: aws.String(channelARN),
+		MediaStorageConfiguration: &awstypes.MediaStorageConfiguration{
+			Status: awstypes.MediaStorageConfigurationStatus(d.Get(names.AttrStatus).(string)),
+		},
+	}
+
+	if v, ok := d.GetOk(names.AttrStreamARN); ok {
+		input.MediaStorageConfiguration.StreamARN = aws.String(v.(string))
+	}
+
+	_, err := conn.UpdateMediaStorageConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Kinesis Video Signaling Channel (%s) media storage configuration: %s", channelARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(channelARN)
+	}
+
+	return append(diags, resourceSignalingChannelMediaStorageConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceSignalingChannelMediaStorageConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	output, err := findSignalingChannelMediaStorageConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Signaling Channel Media Storage Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Video Signaling Channel Media Storage Configuration (%s): %s", d.Id(), err)
+	}
+
+	d.Set("channel_arn", d.Id())
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStreamARN, output.StreamARN)
+
+	return diags
+}
+
+func resourceSignalingChannelMediaStorageConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	// Media storage configuration cannot be deleted, only disabled.
+	log.Printf("[DEBUG] Deleting Kinesis Video Signaling Channel Media Storage Configuration: %s", d.Id())
+	_, err := conn.UpdateMediaStorageConfiguration(ctx, &kinesisvideo.UpdateMediaStorageConfigurationInput{
+		ChannelARN: aws.String(d.Id()),
+		MediaStorageConfiguration: &awstypes.MediaStorageConfiguration{
+			Status: awstypes.MediaStorageConfigurationStatusDisabled,
+		},
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Video Signaling Channel Media Storage Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findSignalingChannelMediaStorageConfigurationByARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.MediaStorageConfiguration, error) {
+	input := &kinesisvideo.DescribeMediaStorageConfigurationInput{
+		ChannelARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeMediaStorageConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.MediaStorageConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.MediaStorageConfiguration, nil
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoSignalingChannelMediaStorageConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kinesis_video_signaling_channel_media_storage_configuration.test"
+	channelResourceName := "aws_kinesis_video_signaling_channel.test"
+	streamResourceName := "aws_kinesis_video_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelMediaStorageConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelMediaStorageConfigurationConfig_basic(rName, string(awstypes.MediaStorageConfigurationStatusEnabled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelMediaStorageConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_arn", channelResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.MediaStorageConfigurationStatusEnabled)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalingChannelMediaStorageConfigurationConfig_basic(rName, string(awstypes.MediaStorageConfigurationStatusDisabled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelMediaStorageConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.MediaStorageConfigurationStatusDisabled)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSignalingChannelMediaStorageConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+		_, err := tfkinesisvideo.FindSignalingChannelMediaStorageConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckSignalingChannelMediaStorageConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesis_video_signaling_channel_media_storage_configuration" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+			output, err := tfkinesisvideo.FindSignalingChannelMediaStorageConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			if output.Status == awstypes.MediaStorageConfigurationStatusDisabled {
+				continue
+			}
+
+			return fmt.Errorf("Kinesis Video Signaling Channel Media Storage Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSignalingChannelMediaStorageConfigurationConfig_basic(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_kinesis_video_signaling_channel" "test" {
+  name = %[1]q
+}
+
+resource "aws_kinesis_video_signaling_channel_media_storage_configuration" "test" {
+  channel_arn = aws_kinesis_video_signaling_channel.test.arn
+  stream_arn  = aws_kinesis_video_stream.test.arn
+  status      = %[2]q
+}
+`, rName, status)
+}
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoSignalingChannel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesis_video_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, resourceName, &channel),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "kinesisvideo", regexache.MustCompile(`channel/`+rName+`/\d+$`)),
+					resource.TestCheckResourceAttr(resourceName, "channel_type", string(awstypes.ChannelTypeSingleMaster)),
+					acctest.CheckResourceAttrRFC3339(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl_seconds", "60"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "0"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrVersion),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesis_video_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, resourceName, &channel),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkinesisvideo.ResourceSignalingChannel(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_messageTTL(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesis_video_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_messageTTL(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl_seconds", "30"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalingChannelConfig_messageTTL(rName, 120),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, "message_ttl_seconds", "120"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoSignalingChannel_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var channel awstypes.ChannelInfo
+	resourceName := "aws_kinesis_video_signaling_channel.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSignalingChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSignalingChannelConfig_tags1(rName, acctest.CtKey1, acctest.CtValue1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+				),
+			},
+			{
+				Config: testAccSignalingChannelConfig_tags2(rName, acctest.CtKey1, acctest.CtValue1, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "2"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey1, acctest.CtValue1),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSignalingChannelConfig_tags1(rName, acctest.CtKey2, acctest.CtValue2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSignalingChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsPercent, "1"),
+					resource.TestCheckResourceAttr(resourceName, acctest.CtTagsKey2, acctest.CtValue2),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSignalingChannelExists(ctx context.Context, n string, v *awstypes.ChannelInfo) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+		output, err := tfkinesisvideo.FindSignalingChannelByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckSignalingChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesis_video_signaling_channel" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+			_, err := tfkinesisvideo.FindSignalingChannelByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kinesis Video Signaling Channel %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccSignalingChannelConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_signaling_channel" "test" {
+  name = %[1]q
+}
+`, rName)
+}
+
+func testAccSignalingChannelConfig_messageTTL(rName string, ttl int) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_signaling_channel" "test" {
+  name                = %[1]q
+  message_ttl_seconds = %[2]d
+}
+`, rName, ttl)
+}
+
+func testAccSignalingChannelConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_signaling_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+  }
+}
+`, rName, tagKey1, tagValue1)
+}
+
+func testAccSignalingChannelConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_signaling_channel" "test" {
+  name = %[1]q
+
+  tags = {
+    %[2]q = %[3]q
+    %[4]q = %[5]q
+  }
+}
+`, rName, tagKey1, tagValue1, tagKey2, tagValue2)
+}
@@ -0,0 +1,477 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kinesis_video_stream_edge_configuration", name="Stream Edge Configuration")
+func resourceStreamEdgeConfiguration() *schema.Resource {
+	scheduleConfigSchema := func() *schema.Schema {
+		return &schema.Schema{
+			Type:     schema.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &schema.Resource{
+				Schema: map[string]*schema.Schema{
+					"duration_in_seconds": {
+						Type:         schema.TypeInt,
+						Required:     true,
+						ValidateFunc: validation.IntBetween(60, 3600),
+					},
+					names.AttrScheduleExpression: {
+						Type:         schema.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringLenBetween(1, 100),
+					},
+				},
+			},
+		}
+	}
+
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamEdgeConfigurationPut,
+		ReadWithoutTimeout:   resourceStreamEdgeConfigurationRead,
+		UpdateWithoutTimeout: resourceStreamEdgeConfigurationPut,
+		DeleteWithoutTimeout: resourceStreamEdgeConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deletion_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"delete_after_upload": {
+							Type:     schema.TypeBool,
+							Optional: true,
+						},
+						"edge_retention_in_hours": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(1, 720),
+						},
+						"local_size_config": {
+							Type:     schema.TypeList,
+							Optional: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"max_local_media_size_in_mb": {
+										Type:         schema.TypeInt,
+										Optional:     true,
+										ValidateFunc: validation.IntBetween(64, 2000000),
+									},
+									"strategy_on_full_size": {
+										Type:             schema.TypeString,
+										Optional:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.StrategyOnFullSize](),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"hub_device_arn": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"recorder_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"media_source_config": {
+							Type:     schema.TypeList,
+							Required: true,
+							MaxItems: 1,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"media_uri_secret_arn": {
+										Type:         schema.TypeString,
+										Required:     true,
+										ValidateFunc: verify.ValidARN,
+									},
+									"media_uri_type": {
+										Type:             schema.TypeString,
+										Required:         true,
+										ValidateDiagFunc: enum.Validate[awstypes.MediaUriType](),
+									},
+								},
+							},
+						},
+						"schedule_config": scheduleConfigSchema(),
+					},
+				},
+			},
+			names.AttrStreamARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sync_status": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"uploader_config": {
+				Type:     schema.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"schedule_config": func() *schema.Schema {
+							s := scheduleConfigSchema()
+							s.Optional = false
+							s.Required = true
+							return s
+						}(),
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceStreamEdgeConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	streamARN := d.Get(names.AttrStreamARN).(string)
+	input := &kinesisvideo.StartEdgeConfigurationUpdateInput{
+		EdgeConfig: &awstypes.EdgeConfig{
+			HubDeviceArn:   aws.String(d.Get("hub_device_arn").(string)),
+			RecorderConfig: expandRecorderConfig(d.Get("recorder_config").([]any)),
+		},
+		StreamARN: aws.String(streamARN),
+	}
+
+	if v, ok := d.GetOk("deletion_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.EdgeConfig.DeletionConfig = expandDeletionConfig(v.([]any)[0].(map[string]any))
+	}
+
+	if v, ok := d.GetOk("uploader_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		input.EdgeConfig.UploaderConfig = &awstypes.UploaderConfig{
+			ScheduleConfig: expandScheduleConfig(v.([]any)[0].(map[string]any)["schedule_config"].([]any)),
+		}
+	}
+
+	_, err := conn.StartEdgeConfigurationUpdate(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Kinesis Video Stream (%s) edge configuration: %s", streamARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(streamARN)
+	}
+
+	timeout := d.Timeout(schema.TimeoutUpdate)
+	if d.IsNewResource() {
+		timeout = d.Timeout(schema.TimeoutCreate)
+	}
+
+	if _, err := waitStreamEdgeConfigurationInSync(ctx, conn, d.Id(), timeout); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Video Stream (%s) edge configuration sync: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceStreamEdgeConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceStreamEdgeConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	output, err := findStreamEdgeConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Stream Edge Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Video Stream Edge Configuration (%s): %s", d.Id(), err)
+	}
+
+	edgeConfig := output.EdgeConfig
+	if err := d.Set("deletion_config", flattenDeletionConfig(edgeConfig.DeletionConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting deletion_config: %s", err)
+	}
+	d.Set("hub_device_arn", edgeConfig.HubDeviceArn)
+	if err := d.Set("recorder_config", flattenRecorderConfig(edgeConfig.RecorderConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recorder_config: %s", err)
+	}
+	d.Set(names.AttrStreamARN, output.StreamARN)
+	d.Set("sync_status", output.SyncStatus)
+	if err := d.Set("uploader_config", flattenUploaderConfig(edgeConfig.UploaderConfig)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting uploader_config: %s", err)
+	}
+
+	return diags
+}
+
+func resourceStreamEdgeConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	log.Printf("[DEBUG] Deleting Kinesis Video Stream Edge Configuration: %s", d.Id())
+	_, err := conn.DeleteEdgeConfiguration(ctx, &kinesisvideo.DeleteEdgeConfigurationInput{
+		StreamARN: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.StreamEdgeConfigurationNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Video Stream Edge Configuration (%s): %s", d.Id(), err)
+	}
+
+	if _, err := waitStreamEdgeConfigurationDeleted(ctx, conn, d.Id(), d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Kinesis Video Stream Edge Configuration (%s) delete: %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findStreamEdgeConfigurationByARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*kinesisvideo.DescribeEdgeConfigurationOutput, error) {
+	input := &kinesisvideo.DescribeEdgeConfigurationInput{
+		StreamARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeEdgeConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsA[*awstypes.StreamEdgeConfigurationNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.EdgeConfig == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusStreamEdgeConfiguration(ctx context.Context, conn *kinesisvideo.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findStreamEdgeConfigurationByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.SyncStatus), nil
+	}
+}
+
+func waitStreamEdgeConfigurationInSync(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*kinesisvideo.DescribeEdgeConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SyncStatusSyncing, awstypes.SyncStatusAcknowledged),
+		Target:     enum.Slice(awstypes.SyncStatusInSync),
+		Refresh:    statusStreamEdgeConfiguration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kinesisvideo.DescribeEdgeConfigurationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailedStatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitStreamEdgeConfigurationDeleted(ctx context.Context, conn *kinesisvideo.Client, arn string, timeout time.Duration) (*kinesisvideo.DescribeEdgeConfigurationOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.SyncStatusDeleting, awstypes.SyncStatusDeletingAcknowledged),
+		Target:     []string{},
+		Refresh:    statusStreamEdgeConfiguration(ctx, conn, arn),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*kinesisvideo.DescribeEdgeConfigurationOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.FailedStatusDetails)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func expandRecorderConfig(tfList []any) *awstypes.RecorderConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+	apiObject := &awstypes.RecorderConfig{}
+
+	if v, ok := tfMap["media_source_config"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.MediaSourceConfig = &awstypes.MediaSourceConfig{
+			MediaUriSecretArn: aws.String(tfMap["media_uri_secret_arn"].(string)),
+			MediaUriType:      awstypes.MediaUriType(tfMap["media_uri_type"].(string)),
+		}
+	}
+
+	if v, ok := tfMap["schedule_config"].([]any); ok {
+		apiObject.ScheduleConfig = expandScheduleConfig(v)
+	}
+
+	return apiObject
+}
+
+func expandScheduleConfig(tfList []any) *awstypes.ScheduleConfig {
+	if len(tfList) == 0 || tfList[0] == nil {
+		return nil
+	}
+
+	tfMap := tfList[0].(map[string]any)
+
+	return &awstypes.ScheduleConfig{
+		DurationInSeconds:  aws.Int32(int32(tfMap["duration_in_seconds"].(int))),
+		ScheduleExpression: aws.String(tfMap[names.AttrScheduleExpression].(string)),
+	}
+}
+
+func expandDeletionConfig(tfMap map[string]any) *awstypes.DeletionConfig {
+	apiObject := &awstypes.DeletionConfig{}
+
+	if v, ok := tfMap["delete_after_upload"].(bool); ok {
+		apiObject.DeleteAfterUpload = aws.Bool(v)
+	}
+
+	if v, ok := tfMap["edge_retention_in_hours"].(int); ok && v != 0 {
+		apiObject.EdgeRetentionInHours = aws.Int32(int32(v))
+	}
+
+	if v, ok := tfMap["local_size_config"].([]any); ok && len(v) > 0 && v[0] != nil {
+		tfMap := v[0].(map[string]any)
+		apiObject.LocalSizeConfig = &awstypes.LocalSizeConfig{}
+
+		if v, ok := tfMap["max_local_media_size_in_mb"].(int); ok && v != 0 {
+			apiObject.LocalSizeConfig.MaxLocalMediaSizeInMB = aws.Int32(int32(v))
+		}
+
+		if v, ok := tfMap["strategy_on_full_size"].(string); ok && v != "" {
+			apiObject.LocalSizeConfig.StrategyOnFullSize = awstypes.StrategyOnFullSize(v)
+		}
+	}
+
+	return apiObject
+}
+
+func flattenRecorderConfig(apiObject *awstypes.RecorderConfig) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"schedule_config": flattenScheduleConfig(apiObject.ScheduleConfig),
+	}
+
+	if v := apiObject.MediaSourceConfig; v != nil {
+		tfMap["media_source_config"] = []any{map[string]any{
+			"media_uri_secret_arn": aws.ToString(v.MediaUriSecretArn),
+			"media_uri_type":       v.MediaUriType,
+		}}
+	}
+
+	return []any{tfMap}
+}
+
+func flattenScheduleConfig(apiObject *awstypes.ScheduleConfig) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"duration_in_seconds":        aws.ToInt32(apiObject.DurationInSeconds),
+		names.AttrScheduleExpression: aws.ToString(apiObject.ScheduleExpression),
+	}
+
+	return []any{tfMap}
+}
+
+func flattenUploaderConfig(apiObject *awstypes.UploaderConfig) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"schedule_config": flattenScheduleConfig(apiObject.ScheduleConfig),
+	}
+
+	return []any{tfMap}
+}
+
+func flattenDeletionConfig(apiObject *awstypes.DeletionConfig) []any {
+	if apiObject == nil {
+		return nil
+	}
+
+	tfMap := map[string]any{
+		"delete_after_upload":     aws.ToBool(apiObject.DeleteAfterUpload),
+		"edge_retention_in_hours": aws.ToInt32(apiObject.EdgeRetentionInHours),
+	}
+
+	if v := apiObject.LocalSizeConfig; v != nil {
+		tfMap["local_size_config"] = []any{map[string]any{
+			"max_local_media_size_in_mb": aws.ToInt32(v.MaxLocalMediaSizeInMB),
+			"strategy_on_full_size":      v.StrategyOnFullSize,
+		}}
+	}
+
+	return []any{tfMap}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Edge configurations require an AWS IoT Greengrass core device running the Kinesis Video Streams Edge Agent.
+func TestAccKinesisVideoStreamEdgeConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "AWS_KINESISVIDEO_EDGE_HUB_DEVICE_ARN"
+	hubDeviceARN := acctest.SkipIfEnvVarNotSet(t, key)
+	resourceName := "aws_kinesis_video_stream_edge_configuration.test"
+	streamResourceName := "aws_kinesis_video_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamEdgeConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamEdgeConfigurationConfig_basic(rName, hubDeviceARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamEdgeConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "hub_device_arn", hubDeviceARN),
+					resource.TestCheckResourceAttr(resourceName, "recorder_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recorder_config.0.media_source_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "recorder_config.0.media_source_config.0.media_uri_type", string(awstypes.MediaUriTypeRtspUri)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "sync_status", string(awstypes.SyncStatusInSync)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStreamEdgeConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+		_, err := tfkinesisvideo.FindStreamEdgeConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckStreamEdgeConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesis_video_stream_edge_configuration" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+			_, err := tfkinesisvideo.FindStreamEdgeConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kinesis Video Stream Edge Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccStreamEdgeConfigurationConfig_basic(rName, hubDeviceARN string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id     = aws_secretsmanager_secret.test.id
+  secret_string = jsonencode({ MediaURI = "rtsp://example.com/stream" })
+}
+
+resource "aws_kinesis_video_stream_edge_configuration" "test" {
+  stream_arn     = aws_kinesis_video_stream.test.arn
+  hub_device_arn = %[2]q
+
+  recorder_config {
+    media_source_config {
+      media_uri_secret_arn = aws_secretsmanager_secret_version.test.arn
+      media_uri_type       = "RTSP_URI"
+    }
+  }
+
+  deletion_config {
+    edge_retention_in_hours = 24
+
+    local_size_config {
+      max_local_media_size_in_mb = 1024
+      strategy_on_full_size      = "DELETE_OLDEST_MEDIA"
+    }
+  }
+}
+`, rName, hubDeviceARN)
+}
@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kinesis_video_stream_image_generation_configuration", name="Stream Image Generation Configuration")
+func resourceStreamImageGenerationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamImageGenerationConfigurationPut,
+		ReadWithoutTimeout:   resourceStreamImageGenerationConfigurationRead,
+		UpdateWithoutTimeout: resourceStreamImageGenerationConfigurationPut,
+		DeleteWithoutTimeout: resourceStreamImageGenerationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_config": {
+				Type:     schema.TypeList,
+				Required: true,
+				MaxItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"destination_region": {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrURI: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			names.AttrFormat: {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Format](),
+			},
+			"format_config": {
+				Type:     schema.TypeMap,
+				Optional: true,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"height_pixels": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 2160),
+			},
+			"image_selector_type": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.ImageSelectorType](),
+			},
+			"sampling_interval": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntBetween(200, 20000),
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ConfigurationStatusEnabled,
+				ValidateDiagFunc: enum.Validate[awstypes.ConfigurationStatus](),
+			},
+			names.AttrStreamARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"width_pixels": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(1, 3840),
+			},
+		},
+	}
+}
+
+func resourceStreamImageGenerationConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	streamARN := d.Get(names.AttrStreamARN).(string)
+	imageGenerationConfiguration := &awstypes.ImageGenerationConfiguration{
+		Format:            awstypes.Format(d.Get(names.AttrFormat).(string)),
+		ImageSelectorType: awstypes.ImageSelectorType(d.Get("image_selector_type").(string)),
+		SamplingInterval:  aws.Int32(int32(d.Get("sampling_interval").(int))),
+		Status:            awstypes.ConfigurationStatus(d.Get(names.AttrStatus).(string)),
+	}
+
+	if v, ok := d.GetOk("destination_config"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil {
+		tfMap := v.([]any)[0].(map[string]any)
+		imageGenerationConfiguration.DestinationConfig = &awstypes.ImageGenerationDestinationConfig{
+			DestinationRegion: aws.String(tfMap["destination_region"].(string)),
+			Uri:               aws.String(tfMap[names.AttrURI].(string)),
+		}
+	}
+
+	if v, ok := d.GetOk("format_config"); ok && len(v.(map[string]any)) > 0 {
+		imageGenerationConfiguration.FormatConfig = flex.ExpandStringValueMap(v.(map[string]any))
+	}
+
+	if v, ok := d.GetOk("height_pixels"); ok {
+		imageGenerationConfiguration.HeightPixels = aws.Int32(int32(v.(int)))
+	}
+
+	if v, ok := d.GetOk("width_pixels"); ok {
+		imageGenerationConfiguration.WidthPixels = aws.Int32(int32(v.(int)))
+	}
+
+	input := &kinesisvideo.UpdateImageGenerationConfigurationInput{
+		ImageGenerationConfiguration: imageGenerationConfiguration,
+		StreamARN:                    aws.String(streamARN),
+	}
+
+	_, err := conn.UpdateImageGenerationConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Kinesis Video Stream (%s) image generation configuration: %s", streamARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(streamARN)
+	}
+
+	return append(diags, resourceStreamImageGenerationConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceStreamImageGenerationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	output, err := findStreamImageGenerationConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Stream Image Generation Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Video Stream Image Generation Configuration (%s): %s", d.Id(), err)
+	}
+
+	if v := output.DestinationConfig; v != nil {
+		if err := d.Set("destination_config", []any{map[string]any{
+			"destination_region": aws.ToString(v.DestinationRegion),
+			names.AttrURI:        aws.ToString(v.Uri),
+		}}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting destination_config: %s", err)
+		}
+	} else {
+		d.Set("destination_config", nil)
+	}
+	d.Set(names.AttrFormat, output.Format)
+	d.Set("format_config", output.FormatConfig)
+	d.Set("height_pixels", output.HeightPixels)
+	d.Set("image_selector_type", output.ImageSelectorType)
+	d.Set("sampling_interval", output.SamplingInterval)
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStreamARN, d.Id())
+	d.Set("width_pixels", output.WidthPixels)
+
+	return diags
+}
+
+func resourceStreamImageGenerationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	// Omitting the configuration removes it from the stream.
+	log.Printf("[DEBUG] Deleting Kinesis Video Stream Image Generation Configuration: %s", d.Id())
+	_, err := conn.UpdateImageGenerationConfiguration(ctx, &kinesisvideo.UpdateImageGenerationConfigurationInput{
+		StreamARN: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Video Stream Image Generation Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findStreamImageGenerationConfigurationByARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.ImageGenerationConfiguration, error) {
+	input := &kinesisvideo.DescribeImageGenerationConfigurationInput{
+		StreamARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeImageGenerationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ImageGenerationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ImageGenerationConfiguration, nil
+}
@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoStreamImageGenerationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kinesis_video_stream_image_generation_configuration.test"
+	streamResourceName := "aws_kinesis_video_stream.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamImageGenerationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamImageGenerationConfigurationConfig_basic(rName, string(awstypes.FormatJpeg), 3000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamImageGenerationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "destination_config.0.destination_region", acctest.Region()),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, string(awstypes.FormatJpeg)),
+					resource.TestCheckResourceAttr(resourceName, "image_selector_type", string(awstypes.ImageSelectorTypeServerTimestamp)),
+					resource.TestCheckResourceAttr(resourceName, "sampling_interval", "3000"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ConfigurationStatusEnabled)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStreamImageGenerationConfigurationConfig_basic(rName, string(awstypes.FormatPng), 5000),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamImageGenerationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, string(awstypes.FormatPng)),
+					resource.TestCheckResourceAttr(resourceName, "sampling_interval", "5000"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoStreamImageGenerationConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kinesis_video_stream_image_generation_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamImageGenerationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamImageGenerationConfigurationConfig_basic(rName, string(awstypes.FormatJpeg), 3000),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamImageGenerationConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkinesisvideo.ResourceStreamImageGenerationConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStreamImageGenerationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+		_, err := tfkinesisvideo.FindStreamImageGenerationConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckStreamImageGenerationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesis_video_stream_image_generation_configuration" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+			_, err := tfkinesisvideo.FindStreamImageGenerationConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kinesis Video Stream Image Generation Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccStreamImageGenerationConfigurationConfig_basic(rName, format string, samplingInterval int) string {
+	return fmt.Sprintf(`
+data "aws_region" "current" {}
+
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_kinesis_video_stream_image_generation_configuration" "test" {
+  stream_arn          = aws_kinesis_video_stream.test.arn
+  format              = %[2]q
+  image_selector_type = "SERVER_TIMESTAMP"
+  sampling_interval   = %[3]d
+
+  destination_config {
+    destination_region = data.aws_region.current.region
+    uri                = "s3://${aws_s3_bucket.test.bucket}/images"
+  }
+}
+`, rName, format, samplingInterval)
+}
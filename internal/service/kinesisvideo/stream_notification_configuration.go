@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo
+
+import (
+	"context"
+	"log"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_kinesis_video_stream_notification_configuration", name="Stream Notification Configuration")
+func resourceStreamNotificationConfiguration() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceStreamNotificationConfigurationPut,
+		ReadWithoutTimeout:   resourceStreamNotificationConfigurationRead,
+		UpdateWithoutTimeout: resourceStreamNotificationConfigurationPut,
+		DeleteWithoutTimeout: resourceStreamNotificationConfigurationDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"destination_uri": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrStatus: {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          awstypes.ConfigurationStatusEnabled,
+				ValidateDiagFunc: enum.Validate[awstypes.ConfigurationStatus](),
+			},
+			names.AttrStreamARN: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+		},
+	}
+}
+
+func resourceStreamNotificationConfigurationPut(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	streamARN := d.Get(names.AttrStreamARN).(string)
+	input := &kinesisvideo.UpdateNotificationConfigurationInput{
+		NotificationConfiguration: &awstypes.NotificationConfiguration{
+			DestinationConfig: &awstypes.NotificationDestinationConfig{
+				Uri: aws.String(d.Get("destination_uri").(string)),
+			},
+			Status: awstypes.ConfigurationStatus(d.Get(names.AttrStatus).(string)),
+		},
+		StreamARN: aws.String(streamARN),
+	}
+
+	_, err := conn.UpdateNotificationConfiguration(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "putting Kinesis Video Stream (%s) notification configuration: %s", streamARN, err)
+	}
+
+	if d.IsNewResource() {
+		d.SetId(streamARN)
+	}
+
+	return append(diags, resourceStreamNotificationConfigurationRead(ctx, d, meta)...)
+}
+
+func resourceStreamNotificationConfigurationRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	output, err := findStreamNotificationConfigurationByARN(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Kinesis Video Stream Notification Configuration (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Kinesis Video Stream Notification Configuration (%s): %s", d.Id(), err)
+	}
+
+	if v := output.DestinationConfig; v != nil {
+		d.Set("destination_uri", v.Uri)
+	} else {
+		d.Set("destination_uri", nil)
+	}
+	d.Set(names.AttrStatus, output.Status)
+	d.Set(names.AttrStreamARN, d.Id())
+
+	return diags
+}
+
+func resourceStreamNotificationConfigurationDelete(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	// Omitting the configuration removes it from the stream.
+	log.Printf("[DEBUG] Deleting Kinesis Video Stream Notification Configuration: %s", d.Id())
+	_, err := conn.UpdateNotificationConfiguration(ctx, &kinesisvideo.UpdateNotificationConfigurationInput{
+		StreamARN: aws.String(d.Id()),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "deleting Kinesis Video Stream Notification Configuration (%s): %s", d.Id(), err)
+	}
+
+	return diags
+}
+
+func findStreamNotificationConfigurationByARN(ctx context.Context, conn *kinesisvideo.Client, arn string) (*awstypes.NotificationConfiguration, error) {
+	input := &kinesisvideo.DescribeNotificationConfigurationInput{
+		StreamARN: aws.String(arn),
+	}
+
+	output, err := conn.DescribeNotificationConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.NotificationConfiguration == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.NotificationConfiguration, nil
+}
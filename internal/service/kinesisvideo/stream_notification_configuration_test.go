@@ -0,0 +1,141 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kinesisvideo_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kinesisvideo/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfkinesisvideo "github.com/hashicorp/terraform-provider-aws/internal/service/kinesisvideo"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKinesisVideoStreamNotificationConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kinesis_video_stream_notification_configuration.test"
+	streamResourceName := "aws_kinesis_video_stream.test"
+	topicResourceName := "aws_sns_topic.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamNotificationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamNotificationConfigurationConfig_basic(rName, string(awstypes.ConfigurationStatusEnabled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamNotificationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "destination_uri", topicResourceName, names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ConfigurationStatusEnabled)),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrStreamARN, streamResourceName, names.AttrARN),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccStreamNotificationConfigurationConfig_basic(rName, string(awstypes.ConfigurationStatusDisabled)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckStreamNotificationConfigurationExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ConfigurationStatusDisabled)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKinesisVideoStreamNotificationConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	resourceName := "aws_kinesis_video_stream_notification_configuration.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.KinesisVideoEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KinesisVideoServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckStreamNotificationConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccStreamNotificationConfigurationConfig_basic(rName, string(awstypes.ConfigurationStatusEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStreamNotificationConfigurationExists(ctx, resourceName),
+					acctest.CheckResourceDisappears(ctx, acctest.Provider, tfkinesisvideo.ResourceStreamNotificationConfiguration(), resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckStreamNotificationConfigurationExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+		_, err := tfkinesisvideo.FindStreamNotificationConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		return err
+	}
+}
+
+func testAccCheckStreamNotificationConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_kinesis_video_stream_notification_configuration" {
+				continue
+			}
+
+			conn := acctest.Provider.Meta().(*conns.AWSClient).KinesisVideoClient(ctx)
+
+			_, err := tfkinesisvideo.FindStreamNotificationConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Kinesis Video Stream Notification Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccStreamNotificationConfigurationConfig_basic(rName, status string) string {
+	return fmt.Sprintf(`
+resource "aws_kinesis_video_stream" "test" {
+  name                    = %[1]q
+  data_retention_in_hours = 1
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_kinesis_video_stream_notification_configuration" "test" {
+  stream_arn      = aws_kinesis_video_stream.test.arn
+  destination_uri = aws_sns_topic.test.arn
+  status          = %[2]q
+}
+`, rName, status)
+}
@@ -0,0 +1,59 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build !generate
+// +build !generate
+
+package kinesisvideo
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
+)
+
+// Streams and signaling channels are tagged through different APIs.
+
+// ListTags lists kinesisvideo service tags and set them in Context.
+// It is called from outside this package.
+func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier, resourceType string) error {
+	var (
+		tags tftags.KeyValueTags
+		err  error
+	)
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	switch resourceType {
+	case "SignalingChannel":
+		tags, err = listSignalingChannelTags(ctx, conn, identifier)
+
+	default:
+		tags, err = listStreamTags(ctx, conn, identifier)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if inContext, ok := tftags.FromContext(ctx); ok {
+		inContext.TagsOut = option.Some(tags)
+	}
+
+	return nil
+}
+
+// UpdateTags updates kinesisvideo service tags.
+// It is called from outside this package.
+func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier, resourceType string, oldTags, newTags any) error {
+	conn := meta.(*conns.AWSClient).KinesisVideoClient(ctx)
+
+	switch resourceType {
+	case "SignalingChannel":
+		return updateSignalingChannelTags(ctx, conn, identifier, oldTags, newTags)
+
+	default:
+		return updateStreamTags(ctx, conn, identifier, oldTags, newTags)
+	}
+}
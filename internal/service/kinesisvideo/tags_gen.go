@@ -9,17 +9,16 @@ import (
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/kinesisvideo"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
-	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/logging"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/internal/types/option"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
-// listTags lists kinesisvideo service tags.
+// listStreamTags lists kinesisvideo service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func listTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, optFns ...func(*kinesisvideo.Options)) (tftags.KeyValueTags, error) {
+func listStreamTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, optFns ...func(*kinesisvideo.Options)) (tftags.KeyValueTags, error) {
 	input := kinesisvideo.ListTagsForStreamInput{
 		StreamARN: aws.String(identifier),
 	}
@@ -43,22 +42,6 @@ func listTags(ctx context.Context, conn *kinesisvideo.Client, identifier string,
 	return keyValueTags(ctx, output), nil
 }
 
-// ListTags lists kinesisvideo service tags and set them in Context.
-// It is called from outside this package.
-func (p *servicePackage) ListTags(ctx context.Context, meta any, identifier string) error {
-	tags, err := listTags(ctx, meta.(*conns.AWSClient).KinesisVideoClient(ctx), identifier)
-
-	if err != nil {
-		return err
-	}
-
-	if inContext, ok := tftags.FromContext(ctx); ok {
-		inContext.TagsOut = option.Some(tags)
-	}
-
-	return nil
-}
-
 // map[string]string handling
 
 // svcTags returns kinesisvideo service tags.
@@ -90,10 +73,10 @@ func setTagsOut(ctx context.Context, tags map[string]string) {
 	}
 }
 
-// updateTags updates kinesisvideo service tags.
+// updateStreamTags updates kinesisvideo service tags.
 // The identifier is typically the Amazon Resource Name (ARN), although
 // it may also be a different identifier depending on the service.
-func updateTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, oldTagsMap, newTagsMap any, optFns ...func(*kinesisvideo.Options)) error {
+func updateStreamTags(ctx context.Context, conn *kinesisvideo.Client, identifier string, oldTagsMap, newTagsMap any, optFns ...func(*kinesisvideo.Options)) error {
 	oldTags := tftags.New(ctx, oldTagsMap)
 	newTags := tftags.New(ctx, newTagsMap)
 
@@ -131,9 +114,3 @@ func updateTags(ctx context.Context, conn *kinesisvideo.Client, identifier strin
 
 	return nil
 }
-
-// UpdateTags updates kinesisvideo service tags.
-// It is called from outside this package.
-func (p *servicePackage) UpdateTags(ctx context.Context, meta any, identifier string, oldTags, newTags any) error {
-	return updateTags(ctx, meta.(*conns.AWSClient).KinesisVideoClient(ctx), identifier, oldTags, newTags)
-}
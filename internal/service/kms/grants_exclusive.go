@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_kms_grants_exclusive", name="Grants Exclusive")
+func newGrantsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &grantsExclusiveResource{}, nil
+}
+
+const (
+	ResNameGrantsExclusive = "Grants Exclusive"
+)
+
+type grantsExclusiveResource struct {
+	framework.ResourceWithModel[grantsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *grantsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"grant_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			names.AttrKeyID: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *grantsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan grantsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var grantIDs []string
+	resp.Diagnostics.Append(plan.GrantIDs.ElementsAs(ctx, &grantIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncGrants(ctx, plan.KeyID.ValueString(), grantIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionCreating, ResNameGrantsExclusive, plan.KeyID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *grantsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().KMSClient(ctx)
+
+	var state grantsExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findGrantIDsByKeyID(ctx, conn, state.KeyID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.KMS, create.ErrActionReading, ResNameGrantsExclusive, state.KeyID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.GrantIDs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *grantsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state grantsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.GrantIDs.Equal(state.GrantIDs) {
+		var grantIDs []string
+		resp.Diagnostics.Append(plan.GrantIDs.ElementsAs(ctx, &grantIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncGrants(ctx, plan.KeyID.ValueString(), grantIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.KMS, create.ErrActionUpdating, ResNameGrantsExclusive, plan.KeyID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncGrants handles keeping the configured grants in sync with the remote
+// KMS key.
+//
+// Grants on the key but not configured on this resource will be revoked.
+// Configured grants which do not exist cannot be created by this resource
+// and will return an error.
+func (r *grantsExclusiveResource) syncGrants(ctx context.Context, keyID string, want []string) error {
+	conn := r.Meta().KMSClient(ctx)
+
+	have, err := findGrantIDsByKeyID(ctx, conn, keyID)
+	if err != nil {
+		return err
+	}
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		return fmt.Errorf("grants not found: %v", missing)
+	}
+
+	for _, grantID := range remove {
+		input := kms.RevokeGrantInput{
+			GrantId: aws.String(grantID),
+			KeyId:   aws.String(keyID),
+		}
+
+		_, err := conn.RevokeGrant(ctx, &input)
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *grantsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrKeyID), req, resp)
+}
+
+func findGrantIDsByKeyID(ctx context.Context, conn *kms.Client, keyID string) ([]string, error) {
+	input := kms.ListGrantsInput{
+		KeyId: aws.String(keyID),
+		Limit: aws.Int32(100),
+	}
+
+	grants, err := findGrants(ctx, conn, &input, tfslices.PredicateTrue[*awstypes.GrantListEntry]())
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(grants, func(v awstypes.GrantListEntry) string {
+		return aws.ToString(v.GrantId)
+	}), nil
+}
+
+type grantsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	GrantIDs fwtypes.SetOfString `tfsdk:"grant_ids"`
+	KeyID    types.String        `tfsdk:"key_id"`
+}
@@ -0,0 +1,155 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package kms_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/kms/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfkms "github.com/hashicorp/terraform-provider-aws/internal/service/kms"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccKMSGrantsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_grants_exclusive.test"
+	keyResourceName := "aws_kms_key.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrKeyID, keyResourceName, names.AttrKeyID),
+					resource.TestCheckResourceAttr(resourceName, "grant_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "grant_ids.*", "aws_kms_grant.test", "grant_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrKeyID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrKeyID,
+			},
+		},
+	})
+}
+
+func TestAccKMSGrantsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_kms_grants_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.KMSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckGrantDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					testAccCheckKeyCreateGrant(ctx, "aws_kms_key.test", "aws_iam_role.test"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccGrantsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckGrantsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "grant_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckGrantsExclusiveExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.KMS, create.ErrActionCheckingExistence, tfkms.ResNameGrantsExclusive, name, errors.New("not found"))
+		}
+
+		keyID := rs.Primary.Attributes[names.AttrKeyID]
+		if keyID == "" {
+			return create.Error(names.KMS, create.ErrActionCheckingExistence, tfkms.ResNameGrantsExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+		out, err := tfkms.FindGrantIDsByKeyID(ctx, conn, keyID)
+		if err != nil {
+			return create.Error(names.KMS, create.ErrActionCheckingExistence, tfkms.ResNameGrantsExclusive, keyID, err)
+		}
+
+		grantCount := rs.Primary.Attributes["grant_ids.#"]
+		if grantCount != strconv.Itoa(len(out)) {
+			return create.Error(names.KMS, create.ErrActionCheckingExistence, tfkms.ResNameGrantsExclusive, keyID, errors.New("unexpected grant_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckKeyCreateGrant(ctx context.Context, keyName, roleName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		key, ok := s.RootModule().Resources[keyName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", keyName)
+		}
+
+		role, ok := s.RootModule().Resources[roleName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", roleName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).KMSClient(ctx)
+
+		input := kms.CreateGrantInput{
+			GranteePrincipal: aws.String(role.Primary.Attributes[names.AttrARN]),
+			KeyId:            aws.String(key.Primary.Attributes[names.AttrKeyID]),
+			Operations:       []awstypes.GrantOperation{awstypes.GrantOperationDecrypt},
+		}
+
+		_, err := conn.CreateGrant(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccGrantsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGrantConfig_base(rName), fmt.Sprintf(`
+resource "aws_kms_grant" "test" {
+  name              = %[1]q
+  key_id            = aws_kms_key.test.key_id
+  grantee_principal = aws_iam_role.test.arn
+  operations        = ["Encrypt", "Decrypt"]
+}
+
+resource "aws_kms_grants_exclusive" "test" {
+  key_id    = aws_kms_key.test.key_id
+  grant_ids = [aws_kms_grant.test.grant_id]
+}
+`, rName))
+}
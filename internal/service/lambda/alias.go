@@ -5,17 +5,23 @@ package lambda
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
@@ -35,6 +41,10 @@ func resourceAlias() *schema.Resource {
 			StateContext: resourceAliasImport,
 		},
 
+		Timeouts: &schema.ResourceTimeout{
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			names.AttrARN: {
 				Type:     schema.TypeString,
@@ -64,9 +74,10 @@ func resourceAlias() *schema.Resource {
 				ForceNew: true,
 			},
 			"routing_config": {
-				Type:     schema.TypeList,
-				Optional: true,
-				MaxItems: 1,
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"traffic_shifting"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"additional_version_weights": {
@@ -77,6 +88,31 @@ func resourceAlias() *schema.Resource {
 					},
 				},
 			},
+			"traffic_shifting": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"routing_config"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"alarm_names": {
+							Type:     schema.TypeSet,
+							Optional: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"step_interval_seconds": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 3600),
+						},
+						"step_percentage": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validation.IntBetween(1, 99),
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -139,11 +175,30 @@ func resourceAliasUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
+	functionName, name := d.Get("function_name").(string), d.Get(names.AttrName).(string)
+
+	if v, ok := d.GetOk("traffic_shifting"); ok && len(v.([]any)) > 0 && v.([]any)[0] != nil && d.HasChange("function_version") {
+		o, n := d.GetChange("function_version")
+		oldVersion, newVersion := o.(string), n.(string)
+
+		// Weighted routing is only supported between published versions.
+		if oldVersion != FunctionVersionLatest && newVersion != FunctionVersionLatest {
+			tfMap := v.([]any)[0].(map[string]any)
+
+			if err := shiftAliasTraffic(ctx, conn, meta.(*conns.AWSClient).CloudWatchClient(ctx), functionName, name, oldVersion, newVersion, tfMap, d.Timeout(schema.TimeoutUpdate)); err != nil {
+				// Record that the alias still targets the old version.
+				d.Set("function_version", oldVersion)
+
+				return sdkdiag.AppendErrorf(diags, "updating Lambda Alias (%s): %s", d.Id(), err)
+			}
+		}
+	}
+
 	input := &lambda.UpdateAliasInput{
 		Description:     aws.String(d.Get(names.AttrDescription).(string)),
-		FunctionName:    aws.String(d.Get("function_name").(string)),
+		FunctionName:    aws.String(functionName),
 		FunctionVersion: aws.String(d.Get("function_version").(string)),
-		Name:            aws.String(d.Get(names.AttrName).(string)),
+		Name:            aws.String(name),
 		RoutingConfig:   expandAliasRoutingConfiguration(d.Get("routing_config").([]any)),
 	}
 
@@ -191,6 +246,107 @@ func resourceAliasImport(ctx context.Context, d *schema.ResourceData, meta any)
 	return []*schema.ResourceData{d}, nil
 }
 
+// shiftAliasTraffic gradually moves an alias's traffic from one function version to another,
+// increasing the weight of the new version by step_percentage every step_interval_seconds.
+// If any of the CloudWatch alarms enters the ALARM state all traffic is routed back to the old version.
+func shiftAliasTraffic(ctx context.Context, conn *lambda.Client, cloudwatchConn *cloudwatch.Client, functionName, name, oldVersion, newVersion string, tfMap map[string]any, timeout time.Duration) error {
+	step := tfMap["step_percentage"].(int)
+	interval := time.Duration(tfMap["step_interval_seconds"].(int)) * time.Second
+	var alarmNames []string
+	if v, ok := tfMap["alarm_names"].(*schema.Set); ok {
+		alarmNames = flex.ExpandStringValueSet(v)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	err := func() error {
+		for weight := step; weight < 100; weight += step {
+			input := lambda.UpdateAliasInput{
+				FunctionName:    aws.String(functionName),
+				FunctionVersion: aws.String(oldVersion),
+				Name:            aws.String(name),
+				RoutingConfig: &awstypes.AliasRoutingConfiguration{
+					AdditionalVersionWeights: map[string]float64{
+						newVersion: float64(weight) / 100,
+					},
+				},
+			}
+
+			if _, err := conn.UpdateAlias(ctx, &input); err != nil {
+				return fmt.Errorf("routing %d%% of traffic to version (%s): %w", weight, newVersion, err)
+			}
+
+			select {
+			case <-ctx.Done():
+				return fmt.Errorf("routing %d%% of traffic to version (%s): %w", weight, newVersion, ctx.Err())
+			case <-time.After(interval):
+			}
+
+			alarms, err := findAlarmsInAlarmState(ctx, cloudwatchConn, alarmNames)
+
+			if err != nil {
+				return fmt.Errorf("reading CloudWatch alarms: %w", err)
+			}
+
+			if len(alarms) > 0 {
+				return fmt.Errorf("CloudWatch alarms (%s) in ALARM state with %d%% of traffic routed to version (%s)", strings.Join(alarms, ", "), weight, newVersion)
+			}
+		}
+
+		return nil
+	}()
+
+	if err != nil {
+		// Roll back even if the timeout has been reached.
+		input := lambda.UpdateAliasInput{
+			FunctionName:    aws.String(functionName),
+			FunctionVersion: aws.String(oldVersion),
+			Name:            aws.String(name),
+			RoutingConfig:   &awstypes.AliasRoutingConfiguration{},
+		}
+
+		if _, rollbackErr := conn.UpdateAlias(context.WithoutCancel(ctx), &input); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("routing all traffic back to version (%s): %w", oldVersion, rollbackErr))
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+func findAlarmsInAlarmState(ctx context.Context, conn *cloudwatch.Client, alarmNames []string) ([]string, error) {
+	if len(alarmNames) == 0 {
+		return nil, nil
+	}
+
+	input := cloudwatch.DescribeAlarmsInput{
+		AlarmNames: alarmNames,
+		AlarmTypes: enum.EnumValues[cloudwatchtypes.AlarmType](),
+		StateValue: cloudwatchtypes.StateValueAlarm,
+	}
+	var output []string
+
+	pages := cloudwatch.NewDescribeAlarmsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MetricAlarms {
+			output = append(output, aws.ToString(v.AlarmName))
+		}
+		for _, v := range page.CompositeAlarms {
+			output = append(output, aws.ToString(v.AlarmName))
+		}
+	}
+
+	return output, nil
+}
+
 func findAliasByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, aliasName string) (*lambda.GetAliasOutput, error) {
 	input := &lambda.GetAliasInput{
 		FunctionName: aws.String(functionName),
@@ -8,7 +8,10 @@ import (
 	"fmt"
 	"testing"
 
+	"github.com/YakDriver/regexache"
 	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -242,6 +245,89 @@ func TestAccLambdaAlias_routing(t *testing.T) {
 	})
 }
 
+func TestAccLambdaAlias_trafficShifting(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_trafficShifting(rName, "test-fixtures/lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shifting.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shifting.0.step_interval_seconds", "5"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shifting.0.step_percentage", "50"),
+				),
+			},
+			{
+				Config: testAccAliasConfig_trafficShifting(rName, "test-fixtures/lambdatest_modified.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaAlias_TrafficShifting_alarmRollback(t *testing.T) {
+	ctx := acctest.Context(t)
+	var conf lambda.GetAliasOutput
+	resourceName := "aws_lambda_alias.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckAliasDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAliasConfig_trafficShiftingAlarm(rName, "test-fixtures/lambdatest.zip"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+					resource.TestCheckResourceAttr(resourceName, "traffic_shifting.0.alarm_names.#", "1"),
+				),
+			},
+			{
+				PreConfig: func() {
+					conn := acctest.Provider.Meta().(*conns.AWSClient).CloudWatchClient(ctx)
+					input := cloudwatch.SetAlarmStateInput{
+						AlarmName:   aws.String(rName),
+						StateReason: aws.String("Testing traffic shifting rollback"),
+						StateValue:  cloudwatchtypes.StateValueAlarm,
+					}
+
+					if _, err := conn.SetAlarmState(ctx, &input); err != nil {
+						t.Fatalf("setting CloudWatch Alarm (%s) state: %s", rName, err)
+					}
+				},
+				Config:      testAccAliasConfig_trafficShiftingAlarm(rName, "test-fixtures/lambdatest_modified.zip"),
+				ExpectError: regexache.MustCompile(`in ALARM state`),
+			},
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckAliasExists(ctx, resourceName, &conf),
+					testAccCheckAliasRoutingDoesNotExistConfig(&conf),
+					resource.TestCheckResourceAttr(resourceName, "function_version", "1"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckAliasDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
@@ -471,3 +557,73 @@ resource "aws_lambda_alias" "test" {
 }
 `, funcName, aliasName))
 }
+
+func testAccAliasConfig_trafficShifting(rName, fileName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = %[2]q
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs20.x"
+  source_code_hash = filebase64sha256(%[2]q)
+  publish          = true
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+
+  traffic_shifting {
+    step_interval_seconds = 5
+    step_percentage       = 50
+  }
+}
+`, rName, fileName))
+}
+
+func testAccAliasConfig_trafficShiftingAlarm(rName, fileName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename         = %[2]q
+  function_name    = %[1]q
+  role             = aws_iam_role.iam_for_lambda.arn
+  handler          = "exports.example"
+  runtime          = "nodejs20.x"
+  source_code_hash = filebase64sha256(%[2]q)
+  publish          = true
+}
+
+resource "aws_cloudwatch_metric_alarm" "test" {
+  alarm_name          = %[1]q
+  comparison_operator = "GreaterThanOrEqualToThreshold"
+  evaluation_periods  = 1
+  metric_name         = "Errors"
+  namespace           = "AWS/Lambda"
+  period              = 3600
+  statistic           = "Sum"
+  threshold           = 1
+
+  dimensions = {
+    FunctionName = aws_lambda_function.test.function_name
+  }
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[1]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = aws_lambda_function.test.version
+
+  traffic_shifting {
+    alarm_names           = [aws_cloudwatch_metric_alarm.test.alarm_name]
+    step_interval_seconds = 5
+    step_percentage       = 50
+  }
+}
+`, rName, fileName))
+}
@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"slices"
+	"strconv"
 	"time"
 
 	"github.com/YakDriver/regexache"
@@ -290,6 +292,12 @@ func resourceFunction() *schema.Resource {
 				Optional: true,
 				Default:  false,
 			},
+			"published_version_retention": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"publish"},
+			},
 			"qualified_arn": {
 				Type:     schema.TypeString,
 				Computed: true,
@@ -459,6 +467,7 @@ func resourceFunction() *schema.Resource {
 
 		CustomizeDiff: customdiff.Sequence(
 			checkHandlerRuntimeForZipFunction,
+			checkPublishedVersionRetentionWithPublish,
 			updateComputedAttributesOnPublish,
 		),
 	}
@@ -1041,6 +1050,12 @@ func resourceFunctionUpdate(ctx context.Context, d *schema.ResourceData, meta an
 		}
 	}
 
+	if v, ok := d.GetOk("published_version_retention"); ok && d.Get("publish").(bool) {
+		if err := deleteUnreferencedFunctionVersions(ctx, conn, d.Id(), v.(int)); err != nil {
+			return sdkdiag.AppendErrorf(diags, "deleting Lambda Function (%s) versions: %s", d.Id(), err)
+		}
+	}
+
 	return append(diags, resourceFunctionRead(ctx, d, meta)...)
 }
 
@@ -1167,6 +1182,210 @@ func findLatestFunctionVersionByName(ctx context.Context, conn *lambda.Client, n
 	return output, nil
 }
 
+func findFunctionVersionsByName(ctx context.Context, conn *lambda.Client, name string) ([]awstypes.FunctionConfiguration, error) {
+	input := lambda.ListVersionsByFunctionInput{
+		FunctionName: aws.String(name),
+		MaxItems:     aws.Int32(listVersionsMaxItems),
+	}
+	var output []awstypes.FunctionConfiguration
+
+	pages := lambda.NewListVersionsByFunctionPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Versions...)
+	}
+
+	return output, nil
+}
+
+func findAliasesByFunctionName(ctx context.Context, conn *lambda.Client, name string) ([]awstypes.AliasConfiguration, error) {
+	input := lambda.ListAliasesInput{
+		FunctionName: aws.String(name),
+	}
+	var output []awstypes.AliasConfiguration
+
+	pages := lambda.NewListAliasesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Aliases...)
+	}
+
+	return output, nil
+}
+
+func findProvisionedConcurrencyConfigsByFunctionName(ctx context.Context, conn *lambda.Client, name string) ([]awstypes.ProvisionedConcurrencyConfigListItem, error) {
+	input := lambda.ListProvisionedConcurrencyConfigsInput{
+		FunctionName: aws.String(name),
+	}
+	var output []awstypes.ProvisionedConcurrencyConfigListItem
+
+	pages := lambda.NewListProvisionedConcurrencyConfigsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ProvisionedConcurrencyConfigs...)
+	}
+
+	return output, nil
+}
+
+func findEventSourceMappingsByFunctionName(ctx context.Context, conn *lambda.Client, name string) ([]awstypes.EventSourceMappingConfiguration, error) {
+	input := lambda.ListEventSourceMappingsInput{
+		FunctionName: aws.String(name),
+	}
+	var output []awstypes.EventSourceMappingConfiguration
+
+	pages := lambda.NewListEventSourceMappingsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.EventSourceMappings...)
+	}
+
+	return output, nil
+}
+
+// deleteUnreferencedFunctionVersions deletes all but the latest retain published versions of a function.
+// Versions that an alias routes traffic to, that have provisioned concurrency or that an event source
+// mapping invokes are never deleted.
+func deleteUnreferencedFunctionVersions(ctx context.Context, conn *lambda.Client, name string, retain int) error {
+	aliases, err := findAliasesByFunctionName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("listing aliases: %w", err)
+	}
+
+	referenced := make(map[string]bool)
+	for _, v := range aliases {
+		referenced[aws.ToString(v.FunctionVersion)] = true
+		if v.RoutingConfig != nil {
+			for version := range v.RoutingConfig.AdditionalVersionWeights {
+				referenced[version] = true
+			}
+		}
+	}
+
+	provisionedConcurrencyConfigs, err := findProvisionedConcurrencyConfigsByFunctionName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("listing provisioned concurrency configs: %w", err)
+	}
+
+	for _, v := range provisionedConcurrencyConfigs {
+		if qualifier, err := getQualifierFromAliasOrVersionARN(aws.ToString(v.FunctionArn)); err == nil {
+			referenced[qualifier] = true
+		}
+	}
+
+	eventSourceMappings, err := findEventSourceMappingsByFunctionName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("listing event source mappings: %w", err)
+	}
+
+	for _, v := range eventSourceMappings {
+		if qualifier, err := getQualifierFromAliasOrVersionARN(aws.ToString(v.FunctionArn)); err == nil {
+			referenced[qualifier] = true
+		}
+	}
+
+	versions, err := findFunctionVersionsByName(ctx, conn, name)
+
+	if err != nil {
+		return fmt.Errorf("listing versions: %w", err)
+	}
+
+	var published []int
+	for _, v := range versions {
+		if version, err := strconv.Atoi(aws.ToString(v.Version)); err == nil {
+			published = append(published, version)
+		}
+	}
+
+	if len(published) <= retain {
+		return nil
+	}
+
+	slices.Sort(published)
+
+	for _, version := range published[:len(published)-retain] {
+		qualifier := strconv.Itoa(version)
+
+		if referenced[qualifier] {
+			continue
+		}
+
+		log.Printf("[INFO] Deleting Lambda Function (%s) version: %s", name, qualifier)
+		input := lambda.DeleteFunctionInput{
+			FunctionName: aws.String(name),
+			Qualifier:    aws.String(qualifier),
+		}
+		_, err := conn.DeleteFunction(ctx, &input)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			continue
+		}
+
+		// The version is still in use by a resource that isn't checked above.
+		if errs.IsA[*awstypes.ResourceConflictException](err) {
+			log.Printf("[WARN] Keeping Lambda Function (%s) version (%s): %s", name, qualifier, err)
+			continue
+		}
+
+		if err != nil {
+			return fmt.Errorf("deleting version (%s): %w", qualifier, err)
+		}
+	}
+
+	return nil
+}
+
 // replaceSecurityGroupsOnDestroy sets the VPC configuration security groups
 // prior to resource destruction
 //
@@ -1407,6 +1626,17 @@ func checkHandlerRuntimeForZipFunction(_ context.Context, d *schema.ResourceDiff
 	return nil
 }
 
+func checkPublishedVersionRetentionWithPublish(_ context.Context, d *schema.ResourceDiff, meta any) error {
+	if !d.NewValueKnown("publish") || !d.NewValueKnown("published_version_retention") {
+		return nil
+	}
+
+	if _, ok := d.GetOk("published_version_retention"); ok && !d.Get("publish").(bool) {
+		return fmt.Errorf("published_version_retention requires publish to be true")
+	}
+	return nil
+}
+
 func updateComputedAttributesOnPublish(_ context.Context, d *schema.ResourceDiff, meta any) error {
 	configChanged := needsFunctionConfigUpdate(d)
 	codeChanged := needsFunctionCodeUpdate(d)
@@ -9,6 +9,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"testing"
 	"time"
 
@@ -615,6 +616,107 @@ func TestAccLambdaFunction_versionedUpdate(t *testing.T) {
 	})
 }
 
+func TestAccLambdaFunction_publishedVersionRetention(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_publishedVersionRetention("test-fixtures/lambdatest.zip", rName, "nodejs20.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionPublishedVersions(ctx, resourceName, "1"),
+					resource.TestCheckResourceAttr(resourceName, "published_version_retention", "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				// Version 1 is kept as it is targeted by the alias.
+				Config: testAccFunctionConfig_publishedVersionRetention("test-fixtures/lambdatest_modified.zip", rName, "nodejs20.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionPublishedVersions(ctx, resourceName, "1", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+			{
+				Config: testAccFunctionConfig_publishedVersionRetention("test-fixtures/lambdatest_modified.zip", rName, "nodejs22.x"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionPublishedVersions(ctx, resourceName, "1", "3"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "3"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_publishedVersionRetentionWithoutPublish(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccFunctionConfig_publishedVersionRetentionWithoutPublish("test-fixtures/lambdatest.zip", rName),
+				ExpectError: regexache.MustCompile(`published_version_retention requires publish to be true`),
+			},
+		},
+	})
+}
+
+func TestAccLambdaFunction_publishedVersionRetentionEventSourceMapping(t *testing.T) {
+	ctx := acctest.Context(t)
+	if testing.Short() {
+		t.Skip("skipping long-running test in short mode")
+	}
+
+	var conf lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionConfig_publishedVersionRetentionEventSourceMapping("test-fixtures/lambdatest.zip", rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionPublishedVersions(ctx, resourceName, "1"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "1"),
+				),
+			},
+			{
+				// Version 1 is kept as it is invoked by the event source mapping.
+				Config: testAccFunctionConfig_publishedVersionRetentionEventSourceMapping("test-fixtures/lambdatest_modified.zip", rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, resourceName, &conf),
+					testAccCheckFunctionPublishedVersions(ctx, resourceName, "1", "2"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrVersion, "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccLambdaFunction_enablePublish(t *testing.T) {
 	ctx := acctest.Context(t)
 	if testing.Short() {
@@ -2375,6 +2477,36 @@ func testAccCheckFunctionExists(ctx context.Context, n string, v *lambda.GetFunc
 	}
 }
 
+func testAccCheckFunctionPublishedVersions(ctx context.Context, n string, want ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindFunctionVersionsByName(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		var got []string
+		for _, v := range output {
+			if version := aws.ToString(v.Version); version != tflambda.FunctionVersionLatest {
+				got = append(got, version)
+			}
+		}
+
+		if !slices.Equal(got, want) {
+			return fmt.Errorf("Lambda Function (%s) published versions = %v, want %v", rs.Primary.ID, got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckFunctionQualifiedInvokeARN(name string, function *lambda.GetFunctionOutput) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		qualifiedArn := fmt.Sprintf("%s:%s", aws.ToString(function.Configuration.FunctionArn), aws.ToString(function.Configuration.Version))
@@ -2995,6 +3127,87 @@ resource "aws_lambda_function" "test" {
 `, fileName, rName, publish))
 }
 
+func testAccFunctionConfig_publishedVersionRetention(fileName, rName, runtime string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename                    = %[1]q
+  function_name               = %[2]q
+  publish                     = true
+  published_version_retention = 1
+  role                        = aws_iam_role.iam_for_lambda.arn
+  handler                     = "exports.example"
+  runtime                     = %[3]q
+  source_code_hash            = filebase64sha256(%[1]q)
+}
+
+resource "aws_lambda_alias" "test" {
+  name             = %[2]q
+  function_name    = aws_lambda_function.test.function_name
+  function_version = "1"
+}
+`, fileName, rName, runtime))
+}
+
+func testAccFunctionConfig_publishedVersionRetentionWithoutPublish(fileName, rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename                    = %[1]q
+  function_name               = %[2]q
+  publish                     = false
+  published_version_retention = 1
+  role                        = aws_iam_role.iam_for_lambda.arn
+  handler                     = "exports.example"
+  runtime                     = "nodejs20.x"
+}
+`, fileName, rName))
+}
+
+func testAccFunctionConfig_publishedVersionRetentionEventSourceMapping(fileName, rName string) string {
+	return acctest.ConfigCompose(
+		acctest.ConfigLambdaBase(rName, rName, rName),
+		fmt.Sprintf(`
+resource "aws_iam_role_policy" "test" {
+  role = aws_iam_role.iam_for_lambda.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect   = "Allow"
+      Action   = ["sqs:*"]
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_sqs_queue" "test" {
+  name = %[2]q
+}
+
+resource "aws_lambda_function" "test" {
+  filename                    = %[1]q
+  function_name               = %[2]q
+  publish                     = true
+  published_version_retention = 1
+  role                        = aws_iam_role.iam_for_lambda.arn
+  handler                     = "exports.example"
+  runtime                     = "nodejs20.x"
+  source_code_hash            = filebase64sha256(%[1]q)
+}
+
+resource "aws_lambda_event_source_mapping" "test" {
+  event_source_arn = aws_sqs_queue.test.arn
+  function_name    = "${aws_lambda_function.test.arn}:1"
+  enabled          = false
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, fileName, rName))
+}
+
 func testAccFunctionConfig_versionedNodeJs22xRuntime(fileName, rName string) string {
 	return acctest.ConfigCompose(
 		acctest.ConfigLambdaBase(rName, rName, rName),
@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_lambda_permissions_exclusive", name="Permissions Exclusive")
+func newPermissionsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &permissionsExclusiveResource{}, nil
+}
+
+const (
+	ResNamePermissionsExclusive = "Permissions Exclusive"
+)
+
+type permissionsExclusiveResource struct {
+	framework.ResourceWithModel[permissionsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *permissionsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"statement_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *permissionsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan permissionsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var statementIDs []string
+	resp.Diagnostics.Append(plan.StatementIDs.ElementsAs(ctx, &statementIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncPermissions(ctx, plan.FunctionName.ValueString(), plan.Qualifier.ValueString(), statementIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Lambda, create.ErrActionCreating, ResNamePermissionsExclusive, plan.FunctionName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *permissionsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().LambdaClient(ctx)
+
+	var state permissionsExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findPolicyStatementIDsByTwoPartKey(ctx, conn, state.FunctionName.ValueString(), state.Qualifier.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.Lambda, create.ErrActionReading, ResNamePermissionsExclusive, state.FunctionName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.StatementIDs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *permissionsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state permissionsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.StatementIDs.Equal(state.StatementIDs) {
+		var statementIDs []string
+		resp.Diagnostics.Append(plan.StatementIDs.ElementsAs(ctx, &statementIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncPermissions(ctx, plan.FunctionName.ValueString(), plan.Qualifier.ValueString(), statementIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.Lambda, create.ErrActionUpdating, ResNamePermissionsExclusive, plan.FunctionName.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncPermissions handles keeping the configured resource-based policy
+// statements in sync with the remote function.
+//
+// Statements present in the function's policy but not configured on this
+// resource will be removed. Configured statements which do not exist cannot
+// be created by this resource and will return an error.
+func (r *permissionsExclusiveResource) syncPermissions(ctx context.Context, functionName, qualifier string, want []string) error {
+	conn := r.Meta().LambdaClient(ctx)
+
+	// Serialize with aws_lambda_permission, which modifies the same policy.
+	conns.GlobalMutexKV.Lock(functionName)
+	defer conns.GlobalMutexKV.Unlock(functionName)
+
+	have, err := findPolicyStatementIDsByTwoPartKey(ctx, conn, functionName, qualifier)
+	if err != nil {
+		return err
+	}
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		return fmt.Errorf("permission statements not found: %v", missing)
+	}
+
+	for _, statementID := range remove {
+		input := lambda.RemovePermissionInput{
+			FunctionName: aws.String(functionName),
+			StatementId:  aws.String(statementID),
+		}
+		if qualifier != "" {
+			input.Qualifier = aws.String(qualifier)
+		}
+
+		_, err := conn.RemovePermission(ctx, &input)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *permissionsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parts := strings.Split(req.ID, intflex.ResourceIdSeparator)
+	if len(parts) > 2 || parts[0] == "" {
+		resp.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(fmt.Errorf("unexpected format for ID (%[1]s), expected FUNCTION_NAME or FUNCTION_NAME%[2]sQUALIFIER", req.ID, intflex.ResourceIdSeparator)))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("function_name"), parts[0])...)
+	if len(parts) == 2 {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("qualifier"), parts[1])...)
+	}
+}
+
+func findPolicyStatementIDsByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) ([]string, error) {
+	input := lambda.GetPolicyInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := findPolicy(ctx, conn, &input)
+
+	// A function without a resource-based policy returns ResourceNotFoundException.
+	if tfresource.NotFound(err) {
+		if _, err := findFunctionConfigurationByTwoPartKey(ctx, conn, functionName, qualifier); err != nil {
+			return nil, err
+		}
+
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	policy := &Policy{}
+	if err := json.Unmarshal([]byte(aws.ToString(output.Policy)), policy); err != nil {
+		return nil, err
+	}
+
+	var statementIDs []string
+	for _, v := range policy.Statement {
+		statementIDs = append(statementIDs, v.Sid)
+	}
+
+	return statementIDs, nil
+}
+
+type permissionsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	FunctionName types.String        `tfsdk:"function_name"`
+	Qualifier    types.String        `tfsdk:"qualifier"`
+	StatementIDs fwtypes.SetOfString `tfsdk:"statement_ids"`
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaPermissionsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var function lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, functionResourceName, &function),
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckNoResourceAttr(resourceName, "qualifier"),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "statement_ids.*", "aws_lambda_permission.test", "statement_id"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "function_name"),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermissionsExclusive_empty(t *testing.T) {
+	ctx := acctest.Context(t)
+	var function lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_empty(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, functionResourceName, &function),
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaPermissionsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	var function lambda.GetFunctionOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_permissions_exclusive.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckFunctionExists(ctx, functionResourceName, &function),
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					testAccCheckFunctionAddPermission(ctx, &function, "OutOfBand"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccPermissionsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckPermissionsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "statement_ids.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckPermissionsExclusiveExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, name, errors.New("not found"))
+		}
+
+		functionName := rs.Primary.Attributes["function_name"]
+		if functionName == "" {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+		out, err := tflambda.FindPolicyStatementIDsByTwoPartKey(ctx, conn, functionName, rs.Primary.Attributes["qualifier"])
+		if err != nil {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, functionName, err)
+		}
+
+		statementCount := rs.Primary.Attributes["statement_ids.#"]
+		if statementCount != strconv.Itoa(len(out)) {
+			return create.Error(names.Lambda, create.ErrActionCheckingExistence, tflambda.ResNamePermissionsExclusive, functionName, errors.New("unexpected statement_ids count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionAddPermission(ctx context.Context, function *lambda.GetFunctionOutput, statementID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		input := lambda.AddPermissionInput{
+			Action:       aws.String("lambda:InvokeFunction"),
+			FunctionName: function.Configuration.FunctionName,
+			Principal:    aws.String("events.amazonaws.com"),
+			StatementId:  aws.String(statementID),
+		}
+
+		_, err := conn.AddPermission(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccPermissionsExclusiveConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_permission" "test" {
+  statement_id  = "AllowExecutionFromCloudWatch"
+  action        = "lambda:InvokeFunction"
+  function_name = aws_lambda_function.test.function_name
+  principal     = "events.amazonaws.com"
+}
+
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  statement_ids = [aws_lambda_permission.test.statement_id]
+}
+`)
+}
+
+func testAccPermissionsExclusiveConfig_empty(rName string) string {
+	return acctest.ConfigCompose(testAccPermissionConfig_base(rName), `
+resource "aws_lambda_permissions_exclusive" "test" {
+  function_name = aws_lambda_function.test.function_name
+  statement_ids = []
+}
+`)
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameChannel         = "Channel"
+	ChannelFieldNamePrefix = "Channel"
+
+	channelResourceIDPartCount = 2
+)
+
+// @FrameworkResource("aws_media_packagev2_channel", name="Channel")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newChannelResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &channelResource{}
+
+	return r, nil
+}
+
+type channelResource struct {
+	framework.ResourceWithModel[channelResourceModel]
+}
+
+func (r *channelResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"channel_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"ingest_endpoints": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[ingestEndpointModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[ingestEndpointModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"input_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.InputType](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"input_switch_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[inputSwitchConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"mqcs_input_switching": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"output_header_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[outputHeaderConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"publish_mqcs": schema.BoolAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *channelResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data channelResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediapackagev2.CreateChannelInput{
+		Tags: getTagsIn(ctx),
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix(ChannelFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateChannel(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionCreating, ResNameChannel, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix(ChannelFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data channelResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findChannelByTwoPartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionReading, ResNameChannel, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix(ChannelFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *channelResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var state, plan channelResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := fwflex.Diff(ctx, plan, state)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := mediapackagev2.UpdateChannelInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix(ChannelFieldNamePrefix))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateChannel(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionUpdating, ResNameChannel, state.Name.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &plan, fwflex.WithFieldNamePrefix(ChannelFieldNamePrefix))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *channelResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data channelResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Channel", map[string]any{
+		"channel_group_name": data.ChannelGroupName.ValueString(),
+		names.AttrName:       data.Name.ValueString(),
+	})
+
+	input := mediapackagev2.DeleteChannelInput{
+		ChannelGroupName: data.ChannelGroupName.ValueStringPointer(),
+		ChannelName:      data.Name.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteChannel(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionDeleting, ResNameChannel, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, 5*time.Minute, func(ctx context.Context) (any, error) {
+		return findChannelByTwoPartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.Name.ValueString())
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionWaitingForDeletion, ResNameChannel, data.Name.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *channelResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, channelResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("channel_group_name"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrName), parts[1])...)
+}
+
+type channelResourceModel struct {
+	framework.WithRegionModel
+	ARN                       types.String                                                    `tfsdk:"arn"`
+	ChannelGroupName          types.String                                                    `tfsdk:"channel_group_name"`
+	Description               types.String                                                    `tfsdk:"description"`
+	IngestEndpoints           fwtypes.ListNestedObjectValueOf[ingestEndpointModel]            `tfsdk:"ingest_endpoints"`
+	InputSwitchConfiguration  fwtypes.ListNestedObjectValueOf[inputSwitchConfigurationModel]  `tfsdk:"input_switch_configuration"`
+	InputType                 fwtypes.StringEnum[awstypes.InputType]                          `tfsdk:"input_type"`
+	Name                      types.String                                                    `tfsdk:"name"`
+	OutputHeaderConfiguration fwtypes.ListNestedObjectValueOf[outputHeaderConfigurationModel] `tfsdk:"output_header_configuration"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
+}
+
+type ingestEndpointModel struct {
+	ID  types.String `tfsdk:"id"`
+	URL types.String `tfsdk:"url"`
+}
+
+type inputSwitchConfigurationModel struct {
+	MQCSInputSwitching types.Bool `tfsdk:"mqcs_input_switching"`
+}
+
+type outputHeaderConfigurationModel struct {
+	PublishMQCS types.Bool `tfsdk:"publish_mqcs"`
+}
+
+func findChannelByTwoPartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName string) (*mediapackagev2.GetChannelOutput, error) {
+	in := &mediapackagev2.GetChannelInput{
+		ChannelGroupName: aws.String(channelGroupName),
+		ChannelName:      aws.String(channelName),
+	}
+
+	out, err := conn.GetChannel(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastRequest: in,
+			LastError:   err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMediaPackageV2Channel_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var channel mediapackagev2.GetChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediapackagev2", regexache.MustCompile(`channelGroup/.+/channel/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_media_packagev2_channel_group.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "ingest_endpoints.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "ingest_endpoints.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", "HLS"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    testAccChannelImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2Channel_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var channel mediapackagev2.GetChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceChannel, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2Channel_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var channel mediapackagev2.GetChannelOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_channel.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckChannelDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccChannelConfig_cmaf(rName, "description1", false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description1"),
+					resource.TestCheckResourceAttr(resourceName, "input_type", "CMAF"),
+					resource.TestCheckResourceAttr(resourceName, "input_switch_configuration.0.mqcs_input_switching", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, "output_header_configuration.0.publish_mqcs", acctest.CtFalse),
+				),
+			},
+			{
+				Config: testAccChannelConfig_cmaf(rName, "description2", true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckChannelExists(ctx, resourceName, &channel),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description2"),
+					resource.TestCheckResourceAttr(resourceName, "input_switch_configuration.0.mqcs_input_switching", acctest.CtTrue),
+					resource.TestCheckResourceAttr(resourceName, "output_header_configuration.0.publish_mqcs", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccChannelImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["channel_group_name"] + "," + rs.Primary.Attributes[names.AttrName], nil
+	}
+}
+
+func testAccCheckChannelDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagev2_channel" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaPackageV2, create.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameChannel, rs.Primary.Attributes[names.AttrName], err)
+			}
+
+			return fmt.Errorf("MediaPackageV2 Channel: %s not deleted", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckChannelExists(ctx context.Context, name string, channel *mediapackagev2.GetChannelOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+		resp, err := tfmediapackagev2.FindChannelByTwoPartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return create.Error(names.MediaPackageV2, create.ErrActionCheckingExistence, tfmediapackagev2.ResNameChannel, rs.Primary.Attributes[names.AttrName], err)
+		}
+
+		*channel = *resp
+
+		return nil
+	}
+}
+
+func testAccChannelConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_channel" "test" {
+  channel_group_name = aws_media_packagev2_channel_group.test.name
+  name               = %[1]q
+}
+`, rName))
+}
+
+func testAccChannelConfig_cmaf(rName, description string, mqcs bool) string {
+	return acctest.ConfigCompose(testAccChannelGroupConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_channel" "test" {
+  channel_group_name = aws_media_packagev2_channel_group.test.name
+  name               = %[1]q
+  description        = %[2]q
+  input_type         = "CMAF"
+
+  input_switch_configuration {
+    mqcs_input_switching = %[3]t
+  }
+
+  output_header_configuration {
+    publish_mqcs = %[3]t
+  }
+}
+`, rName, description, mqcs))
+}
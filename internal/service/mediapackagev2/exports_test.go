@@ -5,6 +5,14 @@ package mediapackagev2
 
 // Exports for use in tests only.
 var (
-	FindChannelGroupByID = findChannelGroupByID
-	ResourceChannelGroup = newChannelGroupResource
+	FindChannelByTwoPartKey                = findChannelByTwoPartKey
+	FindChannelGroupByID                   = findChannelGroupByID
+	FindChannelPolicyByTwoPartKey          = findChannelPolicyByTwoPartKey
+	FindOriginEndpointByThreePartKey       = findOriginEndpointByThreePartKey
+	FindOriginEndpointPolicyByThreePartKey = findOriginEndpointPolicyByThreePartKey
+	ResourceChannel                        = newChannelResource
+	ResourceChannelGroup                   = newChannelGroupResource
+	ResourceChannelPolicy                  = newChannelPolicyResource
+	ResourceOriginEndpoint                 = newOriginEndpointResource
+	ResourceOriginEndpointPolicy           = newOriginEndpointPolicyResource
 )
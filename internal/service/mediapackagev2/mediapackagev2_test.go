@@ -21,6 +21,26 @@ func TestAccMediaPackageV2_serial(t *testing.T) {
 			acctest.CtDisappears: testAccMediaPackageV2ChannelGroup_disappears,
 			"tags":               testAccMediaPackageV2ChannelGroup_tagsSerial,
 		},
+		"Channel": {
+			acctest.CtBasic:      testAccMediaPackageV2Channel_basic,
+			acctest.CtDisappears: testAccMediaPackageV2Channel_disappears,
+			"update":             testAccMediaPackageV2Channel_update,
+		},
+		"ChannelPolicy": {
+			acctest.CtBasic:      testAccMediaPackageV2ChannelPolicy_basic,
+			acctest.CtDisappears: testAccMediaPackageV2ChannelPolicy_disappears,
+		},
+		"OriginEndpoint": {
+			acctest.CtBasic:      testAccMediaPackageV2OriginEndpoint_basic,
+			acctest.CtDisappears: testAccMediaPackageV2OriginEndpoint_disappears,
+			"dash":               testAccMediaPackageV2OriginEndpoint_dash,
+			"encryption":         testAccMediaPackageV2OriginEndpoint_encryption,
+			"update":             testAccMediaPackageV2OriginEndpoint_update,
+		},
+		"OriginEndpointPolicy": {
+			acctest.CtBasic:      testAccMediaPackageV2OriginEndpointPolicy_basic,
+			acctest.CtDisappears: testAccMediaPackageV2OriginEndpointPolicy_disappears,
+		},
 	}
 
 	acctest.RunSerialTests2Levels(t, testCases, 0)
@@ -0,0 +1,802 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int32planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameOriginEndpoint         = "Origin Endpoint"
+	OriginEndpointFieldNamePrefix = "OriginEndpoint"
+
+	originEndpointResourceIDPartCount = 3
+)
+
+// @FrameworkResource("aws_media_packagev2_origin_endpoint", name="Origin Endpoint")
+// @Tags(identifierAttribute="arn")
+// @Testing(tagsTest=false)
+func newOriginEndpointResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &originEndpointResource{}
+
+	return r, nil
+}
+
+type originEndpointResource struct {
+	framework.ResourceWithModel[originEndpointResourceModel]
+}
+
+func (r *originEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	filterConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[filterConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"clip_start_time": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"end": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"manifest_filter": schema.StringAttribute{
+					Optional: true,
+				},
+				"start": schema.StringAttribute{
+					CustomType: timetypes.RFC3339Type{},
+					Optional:   true,
+				},
+				"time_delay_seconds": schema.Int32Attribute{
+					Optional: true,
+					Validators: []validator.Int32{
+						int32validator.Between(0, 1209600),
+					},
+				},
+			},
+		},
+	}
+	manifestNameAttribute := schema.StringAttribute{
+		Required: true,
+	}
+	manifestURLAttribute := schema.StringAttribute{
+		Computed: true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.UseStateForUnknown(),
+		},
+	}
+	manifestWindowSecondsAttribute := schema.Int32Attribute{
+		Optional: true,
+		Computed: true,
+		PlanModifiers: []planmodifier.Int32{
+			int32planmodifier.UseStateForUnknown(),
+		},
+	}
+	hlsManifestBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[hlsManifestModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"child_manifest_name": schema.StringAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.String{
+						stringplanmodifier.UseStateForUnknown(),
+					},
+				},
+				"manifest_name":           manifestNameAttribute,
+				"manifest_window_seconds": manifestWindowSecondsAttribute,
+				"program_date_time_interval_seconds": schema.Int32Attribute{
+					Optional: true,
+				},
+				names.AttrURL: manifestURLAttribute,
+				"url_encode_child_manifest": schema.BoolAttribute{
+					Optional: true,
+					Computed: true,
+					PlanModifiers: []planmodifier.Bool{
+						boolplanmodifier.UseStateForUnknown(),
+					},
+				},
+			},
+			Blocks: map[string]schema.Block{
+				"filter_configuration": filterConfigurationBlock,
+				"scte_hls": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[scteHLSModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"ad_marker_hls": schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.AdMarkerHls](),
+								Optional:   true,
+							},
+						},
+					},
+				},
+				"start_tag": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[startTagModel](ctx),
+					Validators: []validator.List{
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							"precise": schema.BoolAttribute{
+								Optional: true,
+							},
+							"time_offset": schema.Float32Attribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"channel_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"container_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ContainerType](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"startover_window_seconds": schema.Int32Attribute{
+				Optional: true,
+				Validators: []validator.Int32{
+					int32validator.Between(60, 1209600),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"dash_manifest": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[dashManifestModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"drm_signaling": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DashDrmSignaling](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"manifest_name":           manifestNameAttribute,
+						"manifest_window_seconds": manifestWindowSecondsAttribute,
+						"min_buffer_time_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"min_update_period_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"period_triggers": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.DashPeriodTrigger](),
+							ElementType: fwtypes.StringEnumType[awstypes.DashPeriodTrigger](),
+							Optional:    true,
+							Computed:    true,
+							PlanModifiers: []planmodifier.Set{
+								setplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"segment_template_format": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.DashSegmentTemplateFormat](),
+							Optional:   true,
+							Computed:   true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"suggested_presentation_delay_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						names.AttrURL: manifestURLAttribute,
+					},
+					Blocks: map[string]schema.Block{
+						"filter_configuration": filterConfigurationBlock,
+						"scte_dash": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[scteDASHModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"ad_marker_dash": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.AdMarkerDash](),
+										Optional:   true,
+									},
+								},
+							},
+						},
+						"utc_timing": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[dashUTCTimingModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"timing_mode": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.DashUtcTimingMode](),
+										Optional:   true,
+									},
+									"timing_source": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"force_endpoint_error_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[forceEndpointErrorConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"endpoint_error_conditions": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringEnumType[awstypes.EndpointErrorCondition](),
+							ElementType: fwtypes.StringEnumType[awstypes.EndpointErrorCondition](),
+							Optional:    true,
+						},
+					},
+				},
+			},
+			"hls_manifest":             hlsManifestBlock,
+			"low_latency_hls_manifest": hlsManifestBlock,
+			"segment": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[segmentModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"include_iframe_only_streams": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"segment_duration_seconds": schema.Int32Attribute{
+							Optional: true,
+							Computed: true,
+							Validators: []validator.Int32{
+								int32validator.Between(1, 30),
+							},
+							PlanModifiers: []planmodifier.Int32{
+								int32planmodifier.UseStateForUnknown(),
+							},
+						},
+						"segment_name": schema.StringAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ts_include_dvb_subtitles": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"ts_use_audio_rendition_group": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							PlanModifiers: []planmodifier.Bool{
+								boolplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"encryption": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"cmaf_exclude_segment_drm_metadata": schema.BoolAttribute{
+										Optional: true,
+									},
+									"constant_initialization_vector": schema.StringAttribute{
+										Optional: true,
+									},
+									"key_rotation_interval_seconds": schema.Int32Attribute{
+										Optional: true,
+										Computed: true,
+										Validators: []validator.Int32{
+											int32validator.Between(300, 31536000),
+										},
+										PlanModifiers: []planmodifier.Int32{
+											int32planmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"encryption_method": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionMethodModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"cmaf_encryption_method": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.CmafEncryptionMethod](),
+													Optional:   true,
+												},
+												"ism_encryption_method": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.IsmEncryptionMethod](),
+													Optional:   true,
+												},
+												"ts_encryption_method": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.TsEncryptionMethod](),
+													Optional:   true,
+												},
+											},
+										},
+									},
+									"speke_key_provider": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[spekeKeyProviderModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"drm_systems": schema.SetAttribute{
+													CustomType:  fwtypes.SetOfStringEnumType[awstypes.DrmSystem](),
+													ElementType: fwtypes.StringEnumType[awstypes.DrmSystem](),
+													Required:    true,
+												},
+												names.AttrResourceID: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrRoleARN: schema.StringAttribute{
+													CustomType: fwtypes.ARNType,
+													Required:   true,
+												},
+												names.AttrURL: schema.StringAttribute{
+													Required: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"encryption_contract_configuration": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[encryptionContractConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.IsRequired(),
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"preset_speke20_audio": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Audio](),
+																Required:   true,
+															},
+															"preset_speke20_video": schema.StringAttribute{
+																CustomType: fwtypes.StringEnumType[awstypes.PresetSpeke20Video](),
+																Required:   true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"scte": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[scteModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"scte_filter": schema.SetAttribute{
+										CustomType:  fwtypes.SetOfStringEnumType[awstypes.ScteFilter](),
+										ElementType: fwtypes.StringEnumType[awstypes.ScteFilter](),
+										Optional:    true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *originEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediapackagev2.CreateOriginEndpointInput{
+		Tags: getTagsIn(ctx),
+	}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateOriginEndpoint(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionCreating, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findOriginEndpointByThreePartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionReading, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var state, plan originEndpointResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	diff, d := fwflex.Diff(ctx, plan, state)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		input := mediapackagev2.UpdateOriginEndpointInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := conn.UpdateOriginEndpoint(ctx, &input)
+		if err != nil {
+			response.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionUpdating, ResNameOriginEndpoint, state.Name.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		response.Diagnostics.Append(fwflex.Flatten(ctx, output, &plan, fwflex.WithFieldNamePrefix(OriginEndpointFieldNamePrefix))...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *originEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "deleting Origin Endpoint", map[string]any{
+		"channel_group_name": data.ChannelGroupName.ValueString(),
+		"channel_name":       data.ChannelName.ValueString(),
+		names.AttrName:       data.Name.ValueString(),
+	})
+
+	input := mediapackagev2.DeleteOriginEndpointInput{
+		ChannelGroupName:   data.ChannelGroupName.ValueStringPointer(),
+		ChannelName:        data.ChannelName.ValueStringPointer(),
+		OriginEndpointName: data.Name.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteOriginEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionDeleting, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, 5*time.Minute, func(ctx context.Context) (any, error) {
+		return findOriginEndpointByThreePartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.Name.ValueString())
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionWaitingForDeletion, ResNameOriginEndpoint, data.Name.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *originEndpointResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, originEndpointResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("channel_group_name"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("channel_name"), parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrName), parts[2])...)
+}
+
+type originEndpointResourceModel struct {
+	framework.WithRegionModel
+	ARN                             types.String                                                          `tfsdk:"arn"`
+	ChannelGroupName                types.String                                                          `tfsdk:"channel_group_name"`
+	ChannelName                     types.String                                                          `tfsdk:"channel_name"`
+	ContainerType                   fwtypes.StringEnum[awstypes.ContainerType]                            `tfsdk:"container_type"`
+	DashManifests                   fwtypes.ListNestedObjectValueOf[dashManifestModel]                    `tfsdk:"dash_manifest"`
+	Description                     types.String                                                          `tfsdk:"description"`
+	ForceEndpointErrorConfiguration fwtypes.ListNestedObjectValueOf[forceEndpointErrorConfigurationModel] `tfsdk:"force_endpoint_error_configuration"`
+	HLSManifests                    fwtypes.ListNestedObjectValueOf[hlsManifestModel]                     `tfsdk:"hls_manifest"`
+	LowLatencyHLSManifests          fwtypes.ListNestedObjectValueOf[hlsManifestModel]                     `tfsdk:"low_latency_hls_manifest"`
+	Name                            types.String                                                          `tfsdk:"name"`
+	Segment                         fwtypes.ListNestedObjectValueOf[segmentModel]                         `tfsdk:"segment"`
+	StartoverWindowSeconds          types.Int32                                                           `tfsdk:"startover_window_seconds"`
+	Tags                            tftags.Map                                                            `tfsdk:"tags"`
+	TagsAll                         tftags.Map                                                            `tfsdk:"tags_all"`
+}
+
+type dashManifestModel struct {
+	DRMSignaling                      fwtypes.StringEnum[awstypes.DashDrmSignaling]             `tfsdk:"drm_signaling"`
+	FilterConfiguration               fwtypes.ListNestedObjectValueOf[filterConfigurationModel] `tfsdk:"filter_configuration"`
+	ManifestName                      types.String                                              `tfsdk:"manifest_name"`
+	ManifestWindowSeconds             types.Int32                                               `tfsdk:"manifest_window_seconds"`
+	MinBufferTimeSeconds              types.Int32                                               `tfsdk:"min_buffer_time_seconds"`
+	MinUpdatePeriodSeconds            types.Int32                                               `tfsdk:"min_update_period_seconds"`
+	PeriodTriggers                    fwtypes.SetOfStringEnum[awstypes.DashPeriodTrigger]       `tfsdk:"period_triggers"`
+	ScteDash                          fwtypes.ListNestedObjectValueOf[scteDASHModel]            `tfsdk:"scte_dash"`
+	SegmentTemplateFormat             fwtypes.StringEnum[awstypes.DashSegmentTemplateFormat]    `tfsdk:"segment_template_format"`
+	SuggestedPresentationDelaySeconds types.Int32                                               `tfsdk:"suggested_presentation_delay_seconds"`
+	URL                               types.String                                              `tfsdk:"url"`
+	UTCTiming                         fwtypes.ListNestedObjectValueOf[dashUTCTimingModel]       `tfsdk:"utc_timing"`
+}
+
+type dashUTCTimingModel struct {
+	TimingMode   fwtypes.StringEnum[awstypes.DashUtcTimingMode] `tfsdk:"timing_mode"`
+	TimingSource types.String                                   `tfsdk:"timing_source"`
+}
+
+type encryptionContractConfigurationModel struct {
+	PresetSpeke20Audio fwtypes.StringEnum[awstypes.PresetSpeke20Audio] `tfsdk:"preset_speke20_audio"`
+	PresetSpeke20Video fwtypes.StringEnum[awstypes.PresetSpeke20Video] `tfsdk:"preset_speke20_video"`
+}
+
+type encryptionMethodModel struct {
+	CMAFEncryptionMethod fwtypes.StringEnum[awstypes.CmafEncryptionMethod] `tfsdk:"cmaf_encryption_method"`
+	ISMEncryptionMethod  fwtypes.StringEnum[awstypes.IsmEncryptionMethod]  `tfsdk:"ism_encryption_method"`
+	TSEncryptionMethod   fwtypes.StringEnum[awstypes.TsEncryptionMethod]   `tfsdk:"ts_encryption_method"`
+}
+
+type encryptionModel struct {
+	CMAFExcludeSegmentDRMMetadata types.Bool                                             `tfsdk:"cmaf_exclude_segment_drm_metadata"`
+	ConstantInitializationVector  types.String                                           `tfsdk:"constant_initialization_vector"`
+	EncryptionMethod              fwtypes.ListNestedObjectValueOf[encryptionMethodModel] `tfsdk:"encryption_method"`
+	KeyRotationIntervalSeconds    types.Int32                                            `tfsdk:"key_rotation_interval_seconds"`
+	SPEKEKeyProvider              fwtypes.ListNestedObjectValueOf[spekeKeyProviderModel] `tfsdk:"speke_key_provider"`
+}
+
+type filterConfigurationModel struct {
+	ClipStartTime    timetypes.RFC3339 `tfsdk:"clip_start_time"`
+	End              timetypes.RFC3339 `tfsdk:"end"`
+	ManifestFilter   types.String      `tfsdk:"manifest_filter"`
+	Start            timetypes.RFC3339 `tfsdk:"start"`
+	TimeDelaySeconds types.Int32       `tfsdk:"time_delay_seconds"`
+}
+
+type forceEndpointErrorConfigurationModel struct {
+	EndpointErrorConditions fwtypes.SetOfStringEnum[awstypes.EndpointErrorCondition] `tfsdk:"endpoint_error_conditions"`
+}
+
+type hlsManifestModel struct {
+	ChildManifestName              types.String                                              `tfsdk:"child_manifest_name"`
+	FilterConfiguration            fwtypes.ListNestedObjectValueOf[filterConfigurationModel] `tfsdk:"filter_configuration"`
+	ManifestName                   types.String                                              `tfsdk:"manifest_name"`
+	ManifestWindowSeconds          types.Int32                                               `tfsdk:"manifest_window_seconds"`
+	ProgramDateTimeIntervalSeconds types.Int32                                               `tfsdk:"program_date_time_interval_seconds"`
+	ScteHLS                        fwtypes.ListNestedObjectValueOf[scteHLSModel]             `tfsdk:"scte_hls"`
+	StartTag                       fwtypes.ListNestedObjectValueOf[startTagModel]            `tfsdk:"start_tag"`
+	URL                            types.String                                              `tfsdk:"url"`
+	URLEncodeChildManifest         types.Bool                                                `tfsdk:"url_encode_child_manifest"`
+}
+
+type scteDASHModel struct {
+	AdMarkerDASH fwtypes.StringEnum[awstypes.AdMarkerDash] `tfsdk:"ad_marker_dash"`
+}
+
+type scteHLSModel struct {
+	AdMarkerHLS fwtypes.StringEnum[awstypes.AdMarkerHls] `tfsdk:"ad_marker_hls"`
+}
+
+type scteModel struct {
+	ScteFilter fwtypes.SetOfStringEnum[awstypes.ScteFilter] `tfsdk:"scte_filter"`
+}
+
+type segmentModel struct {
+	Encryption               fwtypes.ListNestedObjectValueOf[encryptionModel] `tfsdk:"encryption"`
+	IncludeIframeOnlyStreams types.Bool                                       `tfsdk:"include_iframe_only_streams"`
+	Scte                     fwtypes.ListNestedObjectValueOf[scteModel]       `tfsdk:"scte"`
+	SegmentDurationSeconds   types.Int32                                      `tfsdk:"segment_duration_seconds"`
+	SegmentName              types.String                                     `tfsdk:"segment_name"`
+	TSIncludeDVBSubtitles    types.Bool                                       `tfsdk:"ts_include_dvb_subtitles"`
+	TSUseAudioRenditionGroup types.Bool                                       `tfsdk:"ts_use_audio_rendition_group"`
+}
+
+type spekeKeyProviderModel struct {
+	DRMSystems                      fwtypes.SetOfStringEnum[awstypes.DrmSystem]                           `tfsdk:"drm_systems"`
+	EncryptionContractConfiguration fwtypes.ListNestedObjectValueOf[encryptionContractConfigurationModel] `tfsdk:"encryption_contract_configuration"`
+	ResourceID                      types.String                                                          `tfsdk:"resource_id"`
+	RoleARN                         fwtypes.ARN                                                           `tfsdk:"role_arn"`
+	URL                             types.String                                                          `tfsdk:"url"`
+}
+
+type startTagModel struct {
+	Precise    types.Bool    `tfsdk:"precise"`
+	TimeOffset types.Float32 `tfsdk:"time_offset"`
+}
+
+func findOriginEndpointByThreePartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointOutput, error) {
+	in := &mediapackagev2.GetOriginEndpointInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	out, err := conn.GetOriginEndpoint(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastRequest: in,
+			LastError:   err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
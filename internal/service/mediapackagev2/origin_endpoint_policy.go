@@ -0,0 +1,237 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mediapackagev2/types"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	ResNameOriginEndpointPolicy = "Origin Endpoint Policy"
+)
+
+// @FrameworkResource("aws_media_packagev2_origin_endpoint_policy", name="Origin Endpoint Policy")
+func newOriginEndpointPolicyResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &originEndpointPolicyResource{}
+
+	return r, nil
+}
+
+type originEndpointPolicyResource struct {
+	framework.ResourceWithModel[originEndpointPolicyResourceModel]
+}
+
+func (r *originEndpointPolicyResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	s := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"channel_group_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"origin_endpoint_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrPolicy: schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Required:   true,
+			},
+		},
+	}
+
+	response.Schema = s
+}
+
+func (r *originEndpointPolicyResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointPolicyResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediapackagev2.PutOriginEndpointPolicyInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutOriginEndpointPolicy(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionCreating, ResNameOriginEndpointPolicy, data.OriginEndpointName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointPolicyResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointPolicyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := findOriginEndpointPolicyByThreePartKey(ctx, conn, data.ChannelGroupName.ValueString(), data.ChannelName.ValueString(), data.OriginEndpointName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionReading, ResNameOriginEndpointPolicy, data.OriginEndpointName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *originEndpointPolicyResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var plan originEndpointPolicyResourceModel
+
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediapackagev2.PutOriginEndpointPolicyInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, plan, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.PutOriginEndpointPolicy(ctx, &input)
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionUpdating, ResNameOriginEndpointPolicy, plan.OriginEndpointName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &plan)...)
+}
+
+func (r *originEndpointPolicyResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().MediaPackageV2Client(ctx)
+	var data originEndpointPolicyResourceModel
+
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := mediapackagev2.DeleteOriginEndpointPolicyInput{
+		ChannelGroupName:   data.ChannelGroupName.ValueStringPointer(),
+		ChannelName:        data.ChannelName.ValueStringPointer(),
+		OriginEndpointName: data.OriginEndpointName.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteOriginEndpointPolicy(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.MediaPackageV2, create.ErrActionDeleting, ResNameOriginEndpointPolicy, data.OriginEndpointName.String(), err),
+			err.Error(),
+		)
+	}
+}
+
+func (r *originEndpointPolicyResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	parts, err := intflex.ExpandResourceId(request.ID, originEndpointResourceIDPartCount, false)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("channel_group_name"), parts[0])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("channel_name"), parts[1])...)
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("origin_endpoint_name"), parts[2])...)
+}
+
+type originEndpointPolicyResourceModel struct {
+	framework.WithRegionModel
+	ChannelGroupName   types.String      `tfsdk:"channel_group_name"`
+	ChannelName        types.String      `tfsdk:"channel_name"`
+	OriginEndpointName types.String      `tfsdk:"origin_endpoint_name"`
+	Policy             fwtypes.IAMPolicy `tfsdk:"policy"`
+}
+
+func findOriginEndpointPolicyByThreePartKey(ctx context.Context, conn *mediapackagev2.Client, channelGroupName, channelName, originEndpointName string) (*mediapackagev2.GetOriginEndpointPolicyOutput, error) {
+	in := &mediapackagev2.GetOriginEndpointPolicyInput{
+		ChannelGroupName:   aws.String(channelGroupName),
+		ChannelName:        aws.String(channelName),
+		OriginEndpointName: aws.String(originEndpointName),
+	}
+
+	out, err := conn.GetOriginEndpointPolicy(ctx, in)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastRequest: in,
+			LastError:   err,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if out == nil || out.Policy == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
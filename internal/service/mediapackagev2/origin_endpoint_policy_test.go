@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMediaPackageV2OriginEndpointPolicy_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointPolicyExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_media_packagev2_channel_group.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_media_packagev2_channel.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "origin_endpoint_name", "aws_media_packagev2_origin_endpoint.test", names.AttrName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrPolicy),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    testAccOriginEndpointPolicyImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "origin_endpoint_name",
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2OriginEndpointPolicy_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint_policy.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointPolicyDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointPolicyConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointPolicyExists(ctx, resourceName),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpointPolicy, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccOriginEndpointPolicyImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["channel_group_name"] + "," + rs.Primary.Attributes["channel_name"] + "," + rs.Primary.Attributes["origin_endpoint_name"], nil
+	}
+}
+
+func testAccCheckOriginEndpointPolicyDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagev2_origin_endpoint_policy" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointPolicyByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes["origin_endpoint_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaPackageV2, create.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameOriginEndpointPolicy, rs.Primary.Attributes["origin_endpoint_name"], err)
+			}
+
+			return fmt.Errorf("MediaPackageV2 Origin Endpoint Policy: %s not deleted", rs.Primary.Attributes["origin_endpoint_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointPolicyExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+		_, err := tfmediapackagev2.FindOriginEndpointPolicyByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes["origin_endpoint_name"])
+
+		if err != nil {
+			return create.Error(names.MediaPackageV2, create.ErrActionCheckingExistence, tfmediapackagev2.ResNameOriginEndpointPolicy, rs.Primary.Attributes["origin_endpoint_name"], err)
+		}
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointPolicyConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccOriginEndpointConfig_basic(rName), `
+resource "aws_media_packagev2_origin_endpoint_policy" "test" {
+  channel_group_name   = aws_media_packagev2_channel_group.test.name
+  channel_name         = aws_media_packagev2_channel.test.name
+  origin_endpoint_name = aws_media_packagev2_origin_endpoint.test.name
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Sid       = "AllowPublicRead"
+      Effect    = "Allow"
+      Principal = "*"
+      Action    = "mediapackagev2:GetObject"
+      Resource  = aws_media_packagev2_origin_endpoint.test.arn
+    }]
+  })
+}
+`)
+}
@@ -0,0 +1,376 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mediapackagev2_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/mediapackagev2"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfmediapackagev2 "github.com/hashicorp/terraform-provider-aws/internal/service/mediapackagev2"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func testAccMediaPackageV2OriginEndpoint_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					acctest.MatchResourceAttrRegionalARN(ctx, resourceName, names.AttrARN, "mediapackagev2", regexache.MustCompile(`channelGroup/.+/channel/.+/originEndpoint/.+`)),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_group_name", "aws_media_packagev2_channel_group.test", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "channel_name", "aws_media_packagev2_channel.test", names.AttrName),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "TS"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "hls_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttrSet(resourceName, "hls_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "segment.#", "1"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    testAccOriginEndpointImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2OriginEndpoint_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfmediapackagev2.ResourceOriginEndpoint, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2OriginEndpoint_dash(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_dash(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "container_type", "CMAF"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.manifest_name", "index"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.segment_template_format", "NUMBER_WITH_TIMELINE"),
+					resource.TestCheckResourceAttr(resourceName, "dash_manifest.0.utc_timing.0.timing_mode", "HTTP_HEAD"),
+					resource.TestCheckResourceAttrSet(resourceName, "dash_manifest.0.url"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "low_latency_hls_manifest.0.manifest_name", "lowlatency"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportStateIdFunc:                    testAccOriginEndpointImportStateIdFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: names.AttrName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2OriginEndpoint_encryption(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_encryption(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.encryption.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.encryption.0.encryption_method.0.cmaf_encryption_method", "CBCS"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.encryption.0.speke_key_provider.0.drm_systems.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.encryption.0.speke_key_provider.0.encryption_contract_configuration.0.preset_speke20_audio", "PRESET_AUDIO_1"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.encryption.0.speke_key_provider.0.encryption_contract_configuration.0.preset_speke20_video", "PRESET_VIDEO_1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccMediaPackageV2OriginEndpoint_update(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var originEndpoint mediapackagev2.GetOriginEndpointOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_media_packagev2_origin_endpoint.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.MediaPackageV2ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckOriginEndpointDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccOriginEndpointConfig_update(rName, "description1", 6, 300),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description1"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "6"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "300"),
+				),
+			},
+			{
+				Config: testAccOriginEndpointConfig_update(rName, "description2", 4, 600),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckOriginEndpointExists(ctx, resourceName, &originEndpoint),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description2"),
+					resource.TestCheckResourceAttr(resourceName, "segment.0.segment_duration_seconds", "4"),
+					resource.TestCheckResourceAttr(resourceName, "startover_window_seconds", "600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOriginEndpointImportStateIdFunc(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes["channel_group_name"] + "," + rs.Primary.Attributes["channel_name"] + "," + rs.Primary.Attributes[names.AttrName], nil
+	}
+}
+
+func testAccCheckOriginEndpointDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_media_packagev2_origin_endpoint" {
+				continue
+			}
+
+			_, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return create.Error(names.MediaPackageV2, create.ErrActionCheckingDestroyed, tfmediapackagev2.ResNameOriginEndpoint, rs.Primary.Attributes[names.AttrName], err)
+			}
+
+			return fmt.Errorf("MediaPackageV2 Origin Endpoint: %s not deleted", rs.Primary.Attributes[names.AttrName])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckOriginEndpointExists(ctx context.Context, name string, originEndpoint *mediapackagev2.GetOriginEndpointOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("Not found: %s", name)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).MediaPackageV2Client(ctx)
+		resp, err := tfmediapackagev2.FindOriginEndpointByThreePartKey(ctx, conn, rs.Primary.Attributes["channel_group_name"], rs.Primary.Attributes["channel_name"], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return create.Error(names.MediaPackageV2, create.ErrActionCheckingExistence, tfmediapackagev2.ResNameOriginEndpoint, rs.Primary.Attributes[names.AttrName], err)
+		}
+
+		*originEndpoint = *resp
+
+		return nil
+	}
+}
+
+func testAccOriginEndpointConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name = aws_media_packagev2_channel_group.test.name
+  channel_name       = aws_media_packagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "TS"
+
+  segment {}
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_dash(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name = aws_media_packagev2_channel_group.test.name
+  channel_name       = aws_media_packagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "CMAF"
+
+  segment {}
+
+  dash_manifest {
+    manifest_name           = "index"
+    segment_template_format = "NUMBER_WITH_TIMELINE"
+
+    utc_timing {
+      timing_mode   = "HTTP_HEAD"
+      timing_source = "https://time.akamai.com"
+    }
+  }
+
+  low_latency_hls_manifest {
+    manifest_name = "lowlatency"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_encryption(rName string) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Effect = "Allow"
+      Principal = {
+        Service = "mediapackagev2.${data.aws_partition.current.dns_suffix}"
+      }
+      Action = "sts:AssumeRole"
+    }]
+  })
+}
+
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name = aws_media_packagev2_channel_group.test.name
+  channel_name       = aws_media_packagev2_channel.test.name
+  name               = %[1]q
+  container_type     = "CMAF"
+
+  segment {
+    encryption {
+      encryption_method {
+        cmaf_encryption_method = "CBCS"
+      }
+
+      speke_key_provider {
+        drm_systems = ["FAIRPLAY"]
+        resource_id = %[1]q
+        role_arn    = aws_iam_role.test.arn
+        url         = "https://example.com/speke"
+
+        encryption_contract_configuration {
+          preset_speke20_audio = "PRESET_AUDIO_1"
+          preset_speke20_video = "PRESET_VIDEO_1"
+        }
+      }
+    }
+  }
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+}
+`, rName))
+}
+
+func testAccOriginEndpointConfig_update(rName, description string, segmentDuration, startoverWindow int) string {
+	return acctest.ConfigCompose(testAccChannelConfig_basic(rName), fmt.Sprintf(`
+resource "aws_media_packagev2_origin_endpoint" "test" {
+  channel_group_name       = aws_media_packagev2_channel_group.test.name
+  channel_name             = aws_media_packagev2_channel.test.name
+  name                     = %[1]q
+  container_type           = "TS"
+  description              = %[2]q
+  startover_window_seconds = %[4]d
+
+  segment {
+    segment_duration_seconds = %[3]d
+  }
+
+  hls_manifest {
+    manifest_name = "index"
+  }
+}
+`, rName, description, segmentDuration, startoverWindow))
+}
@@ -24,6 +24,15 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newChannelResource,
+			TypeName: "aws_media_packagev2_channel",
+			Name:     "Channel",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newChannelGroupResource,
 			TypeName: "aws_media_packagev2_channel_group",
@@ -33,6 +42,27 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newChannelPolicyResource,
+			TypeName: "aws_media_packagev2_channel_policy",
+			Name:     "Channel Policy",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newOriginEndpointResource,
+			TypeName: "aws_media_packagev2_origin_endpoint",
+			Name:     "Origin Endpoint",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newOriginEndpointPolicyResource,
+			TypeName: "aws_media_packagev2_origin_endpoint_policy",
+			Name:     "Origin Endpoint Policy",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+const (
+	ResNameApplication                      = "Application"
+	ResNameLaunchConfigurationTemplate      = "Launch Configuration Template"
+	ResNameReplicationConfigurationTemplate = "Replication Configuration Template"
+	ResNameWave                             = "Wave"
+
+	DSNameSourceServer = "Source Server Data Source"
+)
@@ -0,0 +1,189 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource("aws_mgn_source_server", name="Source Server")
+// @Tags
+func newSourceServerDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &sourceServerDataSource{}, nil
+}
+
+type sourceServerDataSource struct {
+	framework.DataSourceWithModel[sourceServerDataSourceModel]
+}
+
+func (d *sourceServerDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+			"data_replication_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.DataReplicationState](),
+				Computed:   true,
+			},
+			"fqdn_for_action_framework": schema.StringAttribute{
+				Computed: true,
+			},
+			"hostname": schema.StringAttribute{
+				Computed: true,
+			},
+			"is_archived": schema.BoolAttribute{
+				Computed: true,
+			},
+			"launched_instance_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"life_cycle_state": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.LifeCycleState](),
+				Computed:   true,
+			},
+			"recommended_instance_type": schema.StringAttribute{
+				Computed: true,
+			},
+			"replication_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReplicationType](),
+				Computed:   true,
+			},
+			"source_server_id": schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrTags: tftags.TagsAttributeComputedOnly(),
+			"user_provided_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"vcenter_client_id": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (d *sourceServerDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data sourceServerDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().MgnClient(ctx)
+
+	id := data.SourceServerID.ValueString()
+	output, err := findSourceServerByID(ctx, conn, id)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, DSNameSourceServer, id, err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if v := output.DataReplicationInfo; v != nil {
+		data.DataReplicationState = fwtypes.StringEnumValue(v.DataReplicationState)
+	}
+	if v := output.LaunchedInstance; v != nil {
+		data.LaunchedInstanceID = flex.StringToFramework(ctx, v.Ec2InstanceID)
+	}
+	if v := output.LifeCycle; v != nil {
+		data.LifeCycleState = fwtypes.StringEnumValue(v.State)
+	}
+	if v := output.SourceProperties; v != nil {
+		if v := v.IdentificationHints; v != nil {
+			data.Hostname = flex.StringToFramework(ctx, v.Hostname)
+		}
+		data.RecommendedInstanceType = flex.StringToFramework(ctx, v.RecommendedInstanceType)
+	}
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSourceServerByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.SourceServer, error) {
+	input := mgn.DescribeSourceServersInput{
+		Filters: &awstypes.DescribeSourceServersRequestFilters{
+			SourceServerIDs: []string{id},
+		},
+	}
+
+	return findSourceServer(ctx, conn, &input)
+}
+
+func findSourceServer(ctx context.Context, conn *mgn.Client, input *mgn.DescribeSourceServersInput) (*awstypes.SourceServer, error) {
+	output, err := findSourceServers(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findSourceServers(ctx context.Context, conn *mgn.Client, input *mgn.DescribeSourceServersInput) ([]awstypes.SourceServer, error) {
+	var output []awstypes.SourceServer
+
+	pages := mgn.NewDescribeSourceServersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+type sourceServerDataSourceModel struct {
+	framework.WithRegionModel
+	ApplicationID           types.String                                      `tfsdk:"application_id"`
+	ARN                     types.String                                      `tfsdk:"arn"`
+	DataReplicationState    fwtypes.StringEnum[awstypes.DataReplicationState] `tfsdk:"data_replication_state" autoflex:"-"`
+	FQDNForActionFramework  types.String                                      `tfsdk:"fqdn_for_action_framework"`
+	Hostname                types.String                                      `tfsdk:"hostname" autoflex:"-"`
+	IsArchived              types.Bool                                        `tfsdk:"is_archived"`
+	LaunchedInstanceID      types.String                                      `tfsdk:"launched_instance_id" autoflex:"-"`
+	LifeCycleState          fwtypes.StringEnum[awstypes.LifeCycleState]       `tfsdk:"life_cycle_state" autoflex:"-"`
+	RecommendedInstanceType types.String                                      `tfsdk:"recommended_instance_type" autoflex:"-"`
+	ReplicationType         fwtypes.StringEnum[awstypes.ReplicationType]      `tfsdk:"replication_type"`
+	SourceServerID          types.String                                      `tfsdk:"source_server_id"`
+	Tags                    tftags.Map                                        `tfsdk:"tags"`
+	UserProvidedID          types.String                                      `tfsdk:"user_provided_id"`
+	VcenterClientID         types.String                                      `tfsdk:"vcenter_client_id"`
+}
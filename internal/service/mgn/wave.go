@@ -0,0 +1,384 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mgn
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mgn"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mgn/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_mgn_wave", name="Wave")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/mgn/types;awstypes;awstypes.Wave")
+func newWaveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &waveResource{}
+
+	return r, nil
+}
+
+type waveResource struct {
+	framework.ResourceWithModel[waveResourceModel]
+	framework.WithImportByID
+}
+
+func (r *waveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(600),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 256),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+	}
+}
+
+var waveFlexOpt = flex.WithFieldNamePrefix("Wave")
+
+func (r *waveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	var input mgn.CreateWaveInput
+	response.Diagnostics.Append(flex.Expand(ctx, data, &input, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWave(ctx, &input)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameWave, data.Name.ValueString(), err)
+
+		return
+	}
+
+	id := aws.ToString(output.WaveID)
+	data.ARN = flex.StringToFramework(ctx, output.Arn)
+	data.ID = types.StringValue(id)
+
+	if !data.ApplicationIDs.IsUnknown() {
+		if err := associateWaveApplications(ctx, conn, id, flex.ExpandFrameworkStringValueSet(ctx, data.ApplicationIDs)); err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameWave, id, err)
+
+			return
+		}
+	}
+
+	applicationIDs, err := findWaveApplicationIDs(ctx, conn, id)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionCreating, ResNameWave, id, err)
+
+		return
+	}
+
+	data.ApplicationIDs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, applicationIDs)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *waveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := data.ID.ValueString()
+	output, err := findWaveByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameWave, id, err)
+
+		return
+	}
+
+	response.Diagnostics.Append(flex.Flatten(ctx, output, &data, waveFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	applicationIDs, err := findWaveApplicationIDs(ctx, conn, id)
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionReading, ResNameWave, id, err)
+
+		return
+	}
+
+	data.ApplicationIDs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, applicationIDs)
+
+	setTagsOut(ctx, output.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *waveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := new.ID.ValueString()
+
+	if !new.Description.Equal(old.Description) || !new.Name.Equal(old.Name) {
+		var input mgn.UpdateWaveInput
+		response.Diagnostics.Append(flex.Expand(ctx, new, &input, waveFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateWave(ctx, &input)
+
+		if err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameWave, id, err)
+
+			return
+		}
+	}
+
+	if !new.ApplicationIDs.IsUnknown() && !new.ApplicationIDs.Equal(old.ApplicationIDs) {
+		o, n := flex.ExpandFrameworkStringValueSet(ctx, old.ApplicationIDs), flex.ExpandFrameworkStringValueSet(ctx, new.ApplicationIDs)
+		add, del := n.Difference(o), o.Difference(n)
+
+		if err := disassociateWaveApplications(ctx, conn, id, del); err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameWave, id, err)
+
+			return
+		}
+
+		if err := associateWaveApplications(ctx, conn, id, add); err != nil {
+			create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionUpdating, ResNameWave, id, err)
+
+			return
+		}
+	} else {
+		new.ApplicationIDs = old.ApplicationIDs
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *waveResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data waveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().MgnClient(ctx)
+
+	id := data.ID.ValueString()
+	tflog.Debug(ctx, "deleting MGN Wave", map[string]any{
+		names.AttrID: id,
+	})
+
+	if err := disassociateWaveApplications(ctx, conn, id, flex.ExpandFrameworkStringValueSet(ctx, data.ApplicationIDs)); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameWave, id, err)
+
+		return
+	}
+
+	// Only archived waves can be deleted.
+	archiveInput := mgn.ArchiveWaveInput{
+		WaveID: aws.String(id),
+	}
+	_, err := conn.ArchiveWave(ctx, &archiveInput)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameWave, id, err)
+
+		return
+	}
+
+	input := mgn.DeleteWaveInput{
+		WaveID: aws.String(id),
+	}
+	_, err = conn.DeleteWave(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		create.AddError(&response.Diagnostics, names.Mgn, create.ErrActionDeleting, ResNameWave, id, err)
+
+		return
+	}
+}
+
+func associateWaveApplications(ctx context.Context, conn *mgn.Client, waveID string, applicationIDs []string) error {
+	if len(applicationIDs) == 0 {
+		return nil
+	}
+
+	input := mgn.AssociateApplicationsInput{
+		ApplicationIDs: applicationIDs,
+		WaveID:         aws.String(waveID),
+	}
+	_, err := conn.AssociateApplications(ctx, &input)
+
+	return err
+}
+
+func disassociateWaveApplications(ctx context.Context, conn *mgn.Client, waveID string, applicationIDs []string) error {
+	if len(applicationIDs) == 0 {
+		return nil
+	}
+
+	input := mgn.DisassociateApplicationsInput{
+		ApplicationIDs: applicationIDs,
+		WaveID:         aws.String(waveID),
+	}
+	_, err := conn.DisassociateApplications(ctx, &input)
+
+	return err
+}
+
+func findWaveByID(ctx context.Context, conn *mgn.Client, id string) (*awstypes.Wave, error) {
+	input := mgn.ListWavesInput{
+		Filters: &awstypes.ListWavesRequestFilters{
+			WaveIDs: []string{id},
+		},
+	}
+
+	return findWave(ctx, conn, &input)
+}
+
+func findWave(ctx context.Context, conn *mgn.Client, input *mgn.ListWavesInput) (*awstypes.Wave, error) {
+	output, err := findWaves(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findWaves(ctx context.Context, conn *mgn.Client, input *mgn.ListWavesInput) ([]awstypes.Wave, error) {
+	var output []awstypes.Wave
+
+	pages := mgn.NewListWavesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.Items...)
+	}
+
+	return output, nil
+}
+
+func findWaveApplicationIDs(ctx context.Context, conn *mgn.Client, waveID string) ([]string, error) {
+	input := mgn.ListApplicationsInput{
+		Filters: &awstypes.ListApplicationsRequestFilters{
+			WaveIDs: []string{waveID},
+		},
+	}
+
+	output, err := findApplications(ctx, conn, &input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.ApplyToAll(output, func(v awstypes.Application) string {
+		return aws.ToString(v.ApplicationID)
+	}), nil
+}
+
+type waveResourceModel struct {
+	framework.WithRegionModel
+	ApplicationIDs fwtypes.SetOfString `tfsdk:"application_ids"`
+	ARN            types.String        `tfsdk:"arn"`
+	Description    types.String        `tfsdk:"description"`
+	ID             types.String        `tfsdk:"id"`
+	Name           types.String        `tfsdk:"name"`
+	Tags           tftags.Map          `tfsdk:"tags"`
+	TagsAll        tftags.Map          `tfsdk:"tags_all"`
+}
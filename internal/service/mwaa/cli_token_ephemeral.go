@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaa"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_mwaa_cli_token", name="CLI Token")
+func newCLITokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &cliTokenEphemeralResource{}, nil
+}
+
+type cliTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[cliTokenEphemeralResourceModel]
+}
+
+func (e *cliTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cli_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"web_server_hostname": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (e *cliTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data cliTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().MWAAClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := mwaa.CreateCliTokenInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.CreateCliToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MWAA CLI Token (%s)", name), err.Error())
+
+		return
+	}
+
+	data.CLIToken = fwflex.StringToFramework(ctx, output.CliToken)
+	data.WebServerHostname = fwflex.StringToFramework(ctx, output.WebServerHostname)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type cliTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	CLIToken          types.String `tfsdk:"cli_token"`
+	Name              types.String `tfsdk:"name"`
+	WebServerHostname types.String `tfsdk:"web_server_hostname"`
+}
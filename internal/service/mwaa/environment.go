@@ -452,44 +452,9 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta a
 		return sdkdiag.AppendErrorf(diags, "reading MWAA Environment (%s): %s", d.Id(), err)
 	}
 
-	d.Set("airflow_configuration_options", environment.AirflowConfigurationOptions)
-	d.Set("airflow_version", environment.AirflowVersion)
-	d.Set(names.AttrARN, environment.Arn)
-	d.Set(names.AttrCreatedAt, aws.ToTime(environment.CreatedAt).String())
-	d.Set("dag_s3_path", environment.DagS3Path)
-	d.Set("database_vpc_endpoint_service", environment.DatabaseVpcEndpointService)
-	d.Set("endpoint_management", environment.EndpointManagement)
-	d.Set("environment_class", environment.EnvironmentClass)
-	d.Set(names.AttrExecutionRoleARN, environment.ExecutionRoleArn)
-	d.Set(names.AttrKMSKey, environment.KmsKey)
-	if err := d.Set("last_updated", flattenLastUpdate(environment.LastUpdate)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting last_updated: %s", err)
-	}
-	if err := d.Set(names.AttrLoggingConfiguration, flattenLoggingConfiguration(environment.LoggingConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting logging_configuration: %s", err)
-	}
-	d.Set("max_workers", environment.MaxWorkers)
-	d.Set("min_workers", environment.MinWorkers)
-	d.Set("max_webservers", environment.MaxWebservers)
-	d.Set("min_webservers", environment.MinWebservers)
-	d.Set(names.AttrName, environment.Name)
-	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(environment.NetworkConfiguration)); err != nil {
-		return sdkdiag.AppendErrorf(diags, "setting network_configuration: %s", err)
+	if err := setEnvironmentAttributes(d, environment); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
 	}
-	d.Set("plugins_s3_object_version", environment.PluginsS3ObjectVersion)
-	d.Set("plugins_s3_path", environment.PluginsS3Path)
-	d.Set("requirements_s3_object_version", environment.RequirementsS3ObjectVersion)
-	d.Set("requirements_s3_path", environment.RequirementsS3Path)
-	d.Set("schedulers", environment.Schedulers)
-	d.Set(names.AttrServiceRoleARN, environment.ServiceRoleArn)
-	d.Set("source_bucket_arn", environment.SourceBucketArn)
-	d.Set("startup_script_s3_object_version", environment.StartupScriptS3ObjectVersion)
-	d.Set("startup_script_s3_path", environment.StartupScriptS3Path)
-	d.Set(names.AttrStatus, environment.Status)
-	d.Set("webserver_access_mode", environment.WebserverAccessMode)
-	d.Set("webserver_url", environment.WebserverUrl)
-	d.Set("webserver_vpc_endpoint_service", environment.WebserverVpcEndpointService)
-	d.Set("weekly_maintenance_window_start", environment.WeeklyMaintenanceWindowStart)
 
 	setTagsOut(ctx, environment.Tags)
 
@@ -638,6 +603,49 @@ func resourceEnvironmentDelete(ctx context.Context, d *schema.ResourceData, meta
 	return diags
 }
 
+func setEnvironmentAttributes(d *schema.ResourceData, environment *awstypes.Environment) error {
+	d.Set("airflow_configuration_options", environment.AirflowConfigurationOptions)
+	d.Set("airflow_version", environment.AirflowVersion)
+	d.Set(names.AttrARN, environment.Arn)
+	d.Set(names.AttrCreatedAt, aws.ToTime(environment.CreatedAt).String())
+	d.Set("dag_s3_path", environment.DagS3Path)
+	d.Set("database_vpc_endpoint_service", environment.DatabaseVpcEndpointService)
+	d.Set("endpoint_management", environment.EndpointManagement)
+	d.Set("environment_class", environment.EnvironmentClass)
+	d.Set(names.AttrExecutionRoleARN, environment.ExecutionRoleArn)
+	d.Set(names.AttrKMSKey, environment.KmsKey)
+	if err := d.Set("last_updated", flattenLastUpdate(environment.LastUpdate)); err != nil {
+		return fmt.Errorf("setting last_updated: %w", err)
+	}
+	if err := d.Set(names.AttrLoggingConfiguration, flattenLoggingConfiguration(environment.LoggingConfiguration)); err != nil {
+		return fmt.Errorf("setting logging_configuration: %w", err)
+	}
+	d.Set("max_workers", environment.MaxWorkers)
+	d.Set("min_workers", environment.MinWorkers)
+	d.Set("max_webservers", environment.MaxWebservers)
+	d.Set("min_webservers", environment.MinWebservers)
+	d.Set(names.AttrName, environment.Name)
+	if err := d.Set(names.AttrNetworkConfiguration, flattenNetworkConfiguration(environment.NetworkConfiguration)); err != nil {
+		return fmt.Errorf("setting network_configuration: %w", err)
+	}
+	d.Set("plugins_s3_object_version", environment.PluginsS3ObjectVersion)
+	d.Set("plugins_s3_path", environment.PluginsS3Path)
+	d.Set("requirements_s3_object_version", environment.RequirementsS3ObjectVersion)
+	d.Set("requirements_s3_path", environment.RequirementsS3Path)
+	d.Set("schedulers", environment.Schedulers)
+	d.Set(names.AttrServiceRoleARN, environment.ServiceRoleArn)
+	d.Set("source_bucket_arn", environment.SourceBucketArn)
+	d.Set("startup_script_s3_object_version", environment.StartupScriptS3ObjectVersion)
+	d.Set("startup_script_s3_path", environment.StartupScriptS3Path)
+	d.Set(names.AttrStatus, environment.Status)
+	d.Set("webserver_access_mode", environment.WebserverAccessMode)
+	d.Set("webserver_url", environment.WebserverUrl)
+	d.Set("webserver_vpc_endpoint_service", environment.WebserverVpcEndpointService)
+	d.Set("weekly_maintenance_window_start", environment.WeeklyMaintenanceWindowStart)
+
+	return nil
+}
+
 func environmentModuleLoggingConfigurationSchema() *schema.Resource {
 	return &schema.Resource{
 		Schema: map[string]*schema.Schema{
@@ -0,0 +1,234 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaa
+
+import (
+	"context"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	cloudwatchtypes "github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/mwaa/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_mwaa_environment", name="Environment")
+// @Tags(identifierAttribute="arn")
+func dataSourceEnvironment() *schema.Resource {
+	dataSourceSchema := sdkv2.ComputedOnlyFromResourceSchema(resourceEnvironment().Schema)
+
+	dataSourceSchema["airflow_configuration_options"].Sensitive = true
+	dataSourceSchema[names.AttrName] = &schema.Schema{
+		Type:     schema.TypeString,
+		Required: true,
+	}
+	dataSourceSchema[names.AttrTags] = tftags.TagsSchemaComputed()
+	delete(dataSourceSchema, names.AttrTagsAll)
+	dataSourceSchema["worker_metrics"] = &schema.Schema{
+		Type:     schema.TypeList,
+		Computed: true,
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"current_worker_count": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"open_slots": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"queued_tasks": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+				"running_tasks": {
+					Type:     schema.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceEnvironmentRead,
+
+		Schema: dataSourceSchema,
+	}
+}
+
+func dataSourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).MWAAClient(ctx)
+
+	name := d.Get(names.AttrName).(string)
+	environment, err := findEnvironmentByName(ctx, conn, name)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading MWAA Environment (%s): %s", name, err)
+	}
+
+	d.SetId(aws.ToString(environment.Name))
+	if err := setEnvironmentAttributes(d, environment); err != nil {
+		return sdkdiag.AppendFromErr(diags, err)
+	}
+
+	// The MWAA API doesn't return the running workers, so they are derived from the
+	// executor metrics that the environment publishes to CloudWatch.
+	metrics, err := findEnvironmentExecutorMetrics(ctx, meta.(*conns.AWSClient).CloudWatchClient(ctx), name)
+
+	switch {
+	case err != nil:
+		diags = sdkdiag.AppendWarningf(diags, "reading MWAA Environment (%s) worker metrics: %s", name, err)
+		d.Set("worker_metrics", nil)
+	case len(metrics) == 0:
+		d.Set("worker_metrics", nil)
+	default:
+		if err := d.Set("worker_metrics", []any{flattenEnvironmentWorkerMetrics(environment, metrics)}); err != nil {
+			return sdkdiag.AppendErrorf(diags, "setting worker_metrics: %s", err)
+		}
+	}
+
+	setTagsOut(ctx, environment.Tags)
+
+	return diags
+}
+
+const (
+	environmentMetricOpenSlots    = "OpenSlots"
+	environmentMetricQueuedTasks  = "QueuedTasks"
+	environmentMetricRunningTasks = "RunningTasks"
+)
+
+// findEnvironmentExecutorMetrics returns the most recent value of each of the
+// environment's Airflow executor metrics, keyed by metric name.
+func findEnvironmentExecutorMetrics(ctx context.Context, conn *cloudwatch.Client, name string) (map[string]float64, error) {
+	const (
+		lookback = 15 * time.Minute
+		period   = 60
+	)
+	metricNames := []string{environmentMetricOpenSlots, environmentMetricQueuedTasks, environmentMetricRunningTasks}
+
+	now := time.Now()
+	input := cloudwatch.GetMetricDataInput{
+		EndTime:   aws.Time(now),
+		ScanBy:    cloudwatchtypes.ScanByTimestampDescending,
+		StartTime: aws.Time(now.Add(-lookback)),
+	}
+	for _, metricName := range metricNames {
+		input.MetricDataQueries = append(input.MetricDataQueries, cloudwatchtypes.MetricDataQuery{
+			Id: aws.String(strings.ToLower(metricName)),
+			MetricStat: &cloudwatchtypes.MetricStat{
+				Metric: &cloudwatchtypes.Metric{
+					Dimensions: []cloudwatchtypes.Dimension{
+						{
+							Name:  aws.String("Environment"),
+							Value: aws.String(name),
+						},
+						{
+							Name:  aws.String("Function"),
+							Value: aws.String("Executor"),
+						},
+					},
+					MetricName: aws.String(metricName),
+					Namespace:  aws.String("AmazonMWAA"),
+				},
+				Period: aws.Int32(period),
+				Stat:   aws.String(string(cloudwatchtypes.StatisticMaximum)),
+			},
+		})
+	}
+
+	output := make(map[string]float64)
+
+	pages := cloudwatch.NewGetMetricDataPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, result := range page.MetricDataResults {
+			id := aws.ToString(result.Id)
+			if _, ok := output[id]; ok || len(result.Values) == 0 {
+				continue
+			}
+
+			// Values are ordered newest first.
+			output[id] = result.Values[0]
+		}
+	}
+
+	metrics := make(map[string]float64, len(output))
+	for _, metricName := range metricNames {
+		if v, ok := output[strings.ToLower(metricName)]; ok {
+			metrics[metricName] = v
+		}
+	}
+
+	return metrics, nil
+}
+
+func flattenEnvironmentWorkerMetrics(environment *awstypes.Environment, metrics map[string]float64) map[string]any {
+	tfMap := map[string]any{}
+
+	openSlots, okOpenSlots := metrics[environmentMetricOpenSlots]
+	if okOpenSlots {
+		tfMap["open_slots"] = int(openSlots)
+	}
+	if v, ok := metrics[environmentMetricQueuedTasks]; ok {
+		tfMap["queued_tasks"] = int(v)
+	}
+	runningTasks, okRunningTasks := metrics[environmentMetricRunningTasks]
+	if okRunningTasks {
+		tfMap["running_tasks"] = int(runningTasks)
+	}
+
+	// Each worker contributes a fixed number of executor slots, so the number of
+	// running workers is the total slot count divided by the tasks per worker.
+	if tasksPerWorker := environmentTasksPerWorker(environment); okOpenSlots && okRunningTasks && tasksPerWorker > 0 {
+		tfMap["current_worker_count"] = int(math.Ceil((openSlots + runningTasks) / float64(tasksPerWorker)))
+	}
+
+	return tfMap
+}
+
+// environmentTasksPerWorker returns the maximum number of tasks a single worker runs
+// concurrently, honoring any celery.worker_autoscale override.
+func environmentTasksPerWorker(environment *awstypes.Environment) int {
+	if v, ok := environment.AirflowConfigurationOptions["celery.worker_autoscale"]; ok {
+		// The value is "max,min".
+		if n, err := strconv.Atoi(strings.TrimSpace(strings.Split(v, ",")[0])); err == nil {
+			return n
+		}
+	}
+
+	// https://docs.aws.amazon.com/mwaa/latest/userguide/environment-class.html.
+	switch aws.ToString(environment.EnvironmentClass) {
+	case "mw1.micro":
+		return 3
+	case "mw1.small":
+		return 5
+	case "mw1.medium":
+		return 10
+	case "mw1.large":
+		return 20
+	case "mw1.xlarge":
+		return 40
+	case "mw1.2xlarge":
+		return 80
+	}
+
+	return 0
+}
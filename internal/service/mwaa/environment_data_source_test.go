@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaa_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccMWAAEnvironmentDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_mwaa_environment.test"
+	resourceName := "aws_mwaa_environment.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.MWAAServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "airflow_version", resourceName, "airflow_version"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrARN, resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "environment_class", resourceName, "environment_class"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrExecutionRoleARN, resourceName, names.AttrExecutionRoleARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "last_updated.#", resourceName, "last_updated.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "max_workers", resourceName, "max_workers"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "min_workers", resourceName, "min_workers"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttrPair(dataSourceName, "network_configuration.#", resourceName, "network_configuration.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "schedulers", resourceName, "schedulers"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "AVAILABLE"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "worker_metrics.#"),
+					resource.TestCheckResourceAttrPair(dataSourceName, acctest.CtTagsPercent, resourceName, acctest.CtTagsPercent),
+					resource.TestCheckResourceAttrPair(dataSourceName, "webserver_url", resourceName, "webserver_url"),
+				),
+			},
+		},
+	})
+}
+
+func testAccEnvironmentDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccEnvironmentConfig_basic(rName), `
+data "aws_mwaa_environment" "test" {
+  name = aws_mwaa_environment.test.name
+}
+`)
+}
@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mwaa
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/mwaa"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @EphemeralResource("aws_mwaa_web_login_token", name="Web Login Token")
+func newWebLoginTokenEphemeralResource(context.Context) (ephemeral.EphemeralResourceWithConfigure, error) {
+	return &webLoginTokenEphemeralResource{}, nil
+}
+
+type webLoginTokenEphemeralResource struct {
+	framework.EphemeralResourceWithModel[webLoginTokenEphemeralResourceModel]
+}
+
+func (e *webLoginTokenEphemeralResource) Schema(ctx context.Context, request ephemeral.SchemaRequest, response *ephemeral.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"airflow_identity": schema.StringAttribute{
+				Computed: true,
+			},
+			"iam_identity": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"web_server_hostname": schema.StringAttribute{
+				Computed: true,
+			},
+			"web_token": schema.StringAttribute{
+				Computed:  true,
+				Sensitive: true,
+			},
+		},
+	}
+}
+
+func (e *webLoginTokenEphemeralResource) Open(ctx context.Context, request ephemeral.OpenRequest, response *ephemeral.OpenResponse) {
+	var data webLoginTokenEphemeralResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := e.Meta().MWAAClient(ctx)
+
+	name := fwflex.StringValueFromFramework(ctx, data.Name)
+	input := mwaa.CreateWebLoginTokenInput{
+		Name: aws.String(name),
+	}
+
+	output, err := conn.CreateWebLoginToken(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating MWAA Web Login Token (%s)", name), err.Error())
+
+		return
+	}
+
+	data.AirflowIdentity = fwflex.StringToFramework(ctx, output.AirflowIdentity)
+	data.IAMIdentity = fwflex.StringToFramework(ctx, output.IamIdentity)
+	data.WebServerHostname = fwflex.StringToFramework(ctx, output.WebServerHostname)
+	data.WebToken = fwflex.StringToFramework(ctx, output.WebToken)
+
+	response.Diagnostics.Append(response.Result.Set(ctx, &data)...)
+}
+
+type webLoginTokenEphemeralResourceModel struct {
+	framework.WithRegionModel
+	AirflowIdentity   types.String `tfsdk:"airflow_identity"`
+	IAMIdentity       types.String `tfsdk:"iam_identity"`
+	Name              types.String `tfsdk:"name"`
+	WebServerHostname types.String `tfsdk:"web_server_hostname"`
+	WebToken          types.String `tfsdk:"web_token"`
+}
@@ -5,7 +5,13 @@ package neptunegraph
 
 // Exports for use in tests only.
 var (
-	ResourceGraph = newGraphResource
+	ResourceGraph                = newGraphResource
+	ResourceGraphSnapshot        = newGraphSnapshotResource
+	ResourceImportTask           = newImportTaskResource
+	ResourcePrivateGraphEndpoint = newPrivateGraphEndpointResource
 
-	FindGraphByID = findGraphByID
+	FindGraphByID                        = findGraphByID
+	FindGraphSnapshotByID                = findGraphSnapshotByID
+	FindImportTaskByID                   = findImportTaskByID
+	FindPrivateGraphEndpointByTwoPartKey = findPrivateGraphEndpointByTwoPartKey
 )
@@ -0,0 +1,380 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_neptunegraph_import_task", name="Import Task")
+func newImportTaskResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &importTaskResource{}
+
+	r.SetDefaultCreateTimeout(120 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type importTaskResource struct {
+	framework.ResourceWithModel[importTaskResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *importTaskResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blank_node_handling": schema.StringAttribute{
+				Description: "The method to handle blank nodes in the dataset. Only applies to the NTRIPLES format.",
+				CustomType:  fwtypes.StringEnumType[awstypes.BlankNodeHandling](),
+				Optional:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"fail_on_error": schema.BoolAttribute{
+				Description: "If set to true, the task halts when an import error is encountered. If set to false, the task skips the data that caused the error and continues if possible.",
+				Optional:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrFormat: schema.StringAttribute{
+				Description: "The format of the data being imported.",
+				CustomType:  fwtypes.StringEnumType[awstypes.Format](),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"graph_identifier": schema.StringAttribute{
+				Description: "The unique identifier of the Neptune Analytics graph to import data into. The graph must be empty.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"parquet_type": schema.StringAttribute{
+				Description: "The parquet type of the import task.",
+				CustomType:  fwtypes.StringEnumType[awstypes.ParquetType](),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrRoleARN: schema.StringAttribute{
+				Description: "The ARN of the IAM role that will allow access to the data that is to be imported.",
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Description: "A URL identifying the location of the data to be imported. This can be an Amazon S3 path, or can point to a Neptune database endpoint or snapshot.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ImportTaskStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *importTaskResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data importTaskResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	graphID := data.GraphIdentifier.ValueString()
+	var input neptunegraph.StartImportTaskInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.StartImportTask(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Neptune Graph Import Task (%s)", graphID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ID = fwflex.StringToFramework(ctx, output.TaskId)
+
+	task, err := waitImportTaskSucceeded(ctx, conn, data.ID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Neptune Graph Import Task (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, task, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *importTaskResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data importTaskResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	output, err := findImportTaskByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Neptune Graph Import Task (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// GetImportTaskOutput param for GraphId differs from StartImportTaskInput param GraphIdentifier.
+	data.GraphIdentifier = fwflex.StringToFramework(ctx, output.GraphId)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *importTaskResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data importTaskResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	// Completed import tasks cannot be deleted; only in-progress tasks are cancelled.
+	output, err := findImportTaskByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Neptune Graph Import Task (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if !slices.Contains(importTaskInProgressStatuses(), output.Status) {
+		return
+	}
+
+	input := neptunegraph.CancelImportTaskInput{
+		TaskIdentifier: data.ID.ValueStringPointer(),
+	}
+
+	_, err = conn.CancelImportTask(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("cancelling Neptune Graph Import Task (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitImportTaskCancelled(ctx, conn, data.ID.ValueString(), r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Neptune Graph Import Task (%s) cancel", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func importTaskInProgressStatuses() []awstypes.ImportTaskStatus {
+	return []awstypes.ImportTaskStatus{
+		awstypes.ImportTaskStatusInitializing,
+		awstypes.ImportTaskStatusExporting,
+		awstypes.ImportTaskStatusAnalyzingData,
+		awstypes.ImportTaskStatusImporting,
+		awstypes.ImportTaskStatusReprovisioning,
+	}
+}
+
+func findImportTaskByID(ctx context.Context, conn *neptunegraph.Client, id string) (*neptunegraph.GetImportTaskOutput, error) {
+	input := neptunegraph.GetImportTaskInput{
+		TaskIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetImportTask(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	if output.Status == awstypes.ImportTaskStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(output.Status),
+			LastRequest: input,
+		}
+	}
+
+	return output, nil
+}
+
+func statusImportTask(ctx context.Context, conn *neptunegraph.Client, id string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findImportTaskByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitImportTaskSucceeded(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetImportTaskOutput, error) {
+	const (
+		delay = 30 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(append(importTaskInProgressStatuses(), awstypes.ImportTaskStatusRollingBack)...),
+		Target:  enum.Slice(awstypes.ImportTaskStatusSucceeded),
+		Refresh: statusImportTask(ctx, conn, id),
+		Delay:   delay,
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetImportTaskOutput); ok {
+		if details := output.ImportTaskDetails; details != nil && details.ErrorDetails != nil {
+			tfresource.SetLastError(err, errors.Join(errors.New(aws.ToString(output.StatusReason)), errors.New(aws.ToString(details.ErrorDetails))))
+		} else {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitImportTaskCancelled(ctx context.Context, conn *neptunegraph.Client, id string, timeout time.Duration) (*neptunegraph.GetImportTaskOutput, error) {
+	const (
+		delay = 10 * time.Second
+	)
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(append(importTaskInProgressStatuses(), awstypes.ImportTaskStatusCancelling, awstypes.ImportTaskStatusRollingBack)...),
+		Target:  enum.Slice(awstypes.ImportTaskStatusCancelled, awstypes.ImportTaskStatusFailed, awstypes.ImportTaskStatusSucceeded),
+		Refresh: statusImportTask(ctx, conn, id),
+		Delay:   delay,
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetImportTaskOutput); ok {
+		tfresource.SetLastError(err, errors.New(aws.ToString(output.StatusReason)))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type importTaskResourceModel struct {
+	framework.WithRegionModel
+	BlankNodeHandling fwtypes.StringEnum[awstypes.BlankNodeHandling] `tfsdk:"blank_node_handling"`
+	FailOnError       types.Bool                                     `tfsdk:"fail_on_error"`
+	Format            fwtypes.StringEnum[awstypes.Format]            `tfsdk:"format"`
+	GraphIdentifier   types.String                                   `tfsdk:"graph_identifier"`
+	ID                types.String                                   `tfsdk:"id"`
+	ParquetType       fwtypes.StringEnum[awstypes.ParquetType]       `tfsdk:"parquet_type"`
+	RoleARN           fwtypes.ARN                                    `tfsdk:"role_arn"`
+	Source            types.String                                   `tfsdk:"source"`
+	Status            fwtypes.StringEnum[awstypes.ImportTaskStatus]  `tfsdk:"status"`
+	Timeouts          timeouts.Value                                 `tfsdk:"timeouts"`
+}
@@ -0,0 +1,344 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package neptunegraph
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/neptunegraph"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/neptunegraph/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_neptunegraph_private_graph_endpoint", name="Private Graph Endpoint")
+func newPrivateGraphEndpointResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &privateGraphEndpointResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type privateGraphEndpointResource struct {
+	framework.ResourceWithModel[privateGraphEndpointResourceModel]
+	framework.WithNoUpdate
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *privateGraphEndpointResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"graph_identifier": schema.StringAttribute{
+				Description: "The unique identifier of the Neptune Analytics graph.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrSubnetIDs: schema.SetAttribute{
+				Description: "The subnets in which the private graph endpoint ENIs are created.",
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplaceIfConfigured(),
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_endpoint_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrVPCID: schema.StringAttribute{
+				Description: "The VPC in which the private graph endpoint needs to be created. If not specified, the default VPC is used.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_security_group_ids": schema.SetAttribute{
+				Description: "Security groups to be attached to the private graph endpoint. If not specified, the default security group is used.",
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *privateGraphEndpointResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data privateGraphEndpointResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	graphID := data.GraphIdentifier.ValueString()
+	var input neptunegraph.CreatePrivateGraphEndpointInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreatePrivateGraphEndpoint(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Neptune Graph Private Graph Endpoint (%s)", graphID), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	vpcID := aws.ToString(output.VpcId)
+	data.VPCID = fwflex.StringValueToFramework(ctx, vpcID)
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating Neptune Graph Private Graph Endpoint", err.Error())
+
+		return
+	}
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	endpoint, err := waitPrivateGraphEndpointCreated(ctx, conn, graphID, vpcID, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Neptune Graph Private Graph Endpoint (%s) create", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, endpoint, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *privateGraphEndpointResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data privateGraphEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	output, err := findPrivateGraphEndpointByTwoPartKey(ctx, conn, data.GraphIdentifier.ValueString(), data.VPCID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Neptune Graph Private Graph Endpoint (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set attributes for import.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *privateGraphEndpointResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data privateGraphEndpointResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().NeptuneGraphClient(ctx)
+
+	graphID, vpcID := data.GraphIdentifier.ValueString(), data.VPCID.ValueString()
+	input := neptunegraph.DeletePrivateGraphEndpointInput{
+		GraphIdentifier: aws.String(graphID),
+		VpcId:           aws.String(vpcID),
+	}
+
+	_, err := conn.DeletePrivateGraphEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Neptune Graph Private Graph Endpoint (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitPrivateGraphEndpointDeleted(ctx, conn, graphID, vpcID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Neptune Graph Private Graph Endpoint (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findPrivateGraphEndpointByTwoPartKey(ctx context.Context, conn *neptunegraph.Client, graphID, vpcID string) (*neptunegraph.GetPrivateGraphEndpointOutput, error) {
+	input := neptunegraph.GetPrivateGraphEndpointInput{
+		GraphIdentifier: aws.String(graphID),
+		VpcId:           aws.String(vpcID),
+	}
+
+	output, err := conn.GetPrivateGraphEndpoint(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPrivateGraphEndpoint(ctx context.Context, conn *neptunegraph.Client, graphID, vpcID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findPrivateGraphEndpointByTwoPartKey(ctx, conn, graphID, vpcID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitPrivateGraphEndpointCreated(ctx context.Context, conn *neptunegraph.Client, graphID, vpcID string, timeout time.Duration) (*neptunegraph.GetPrivateGraphEndpointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PrivateGraphEndpointStatusCreating),
+		Target:  enum.Slice(awstypes.PrivateGraphEndpointStatusAvailable),
+		Refresh: statusPrivateGraphEndpoint(ctx, conn, graphID, vpcID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetPrivateGraphEndpointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitPrivateGraphEndpointDeleted(ctx context.Context, conn *neptunegraph.Client, graphID, vpcID string, timeout time.Duration) (*neptunegraph.GetPrivateGraphEndpointOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.PrivateGraphEndpointStatusDeleting, awstypes.PrivateGraphEndpointStatusAvailable),
+		Target:  []string{},
+		Refresh: statusPrivateGraphEndpoint(ctx, conn, graphID, vpcID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*neptunegraph.GetPrivateGraphEndpointOutput); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type privateGraphEndpointResourceModel struct {
+	framework.WithRegionModel
+	GraphIdentifier     types.String        `tfsdk:"graph_identifier"`
+	ID                  types.String        `tfsdk:"id"`
+	SubnetIDs           fwtypes.SetOfString `tfsdk:"subnet_ids"`
+	Timeouts            timeouts.Value      `tfsdk:"timeouts"`
+	VPCEndpointID       types.String        `tfsdk:"vpc_endpoint_id"`
+	VPCID               types.String        `tfsdk:"vpc_id"`
+	VPCSecurityGroupIDs fwtypes.SetOfString `tfsdk:"vpc_security_group_ids"`
+}
+
+const (
+	privateGraphEndpointResourceIDPartCount = 2
+)
+
+func (data *privateGraphEndpointResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), privateGraphEndpointResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.GraphIdentifier = types.StringValue(parts[0])
+	data.VPCID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *privateGraphEndpointResourceModel) setID() (string, error) {
+	parts := []string{
+		data.GraphIdentifier.ValueString(),
+		data.VPCID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, privateGraphEndpointResourceIDPartCount, false)
+}
@@ -33,6 +33,27 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newGraphSnapshotResource,
+			TypeName: "aws_neptunegraph_graph_snapshot",
+			Name:     "Graph Snapshot",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newImportTaskResource,
+			TypeName: "aws_neptunegraph_import_task",
+			Name:     "Import Task",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newPrivateGraphEndpointResource,
+			TypeName: "aws_neptunegraph_private_graph_endpoint",
+			Name:     "Private Graph Endpoint",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -19,6 +19,7 @@ import (
 
 func RegisterSweepers() {
 	awsv2.Register("aws_neptunegraph_graph", sweepGraphs)
+	awsv2.Register("aws_neptunegraph_graph_snapshot", sweepGraphSnapshots)
 }
 
 func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
@@ -62,3 +63,25 @@ func sweepGraphs(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepabl
 
 	return sweepResources, nil
 }
+
+func sweepGraphSnapshots(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	var input neptunegraph.ListGraphSnapshotsInput
+	conn := client.NeptuneGraphClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := neptunegraph.NewListGraphSnapshotsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.GraphSnapshots {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newGraphSnapshotResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id))))
+		}
+	}
+
+	return sweepResources, nil
+}
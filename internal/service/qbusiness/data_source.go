@@ -0,0 +1,596 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness/document"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_data_source", name="Data Source")
+// @Tags(identifierAttribute="arn")
+func newDataSourceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &dataSourceResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameDataSource = "Data Source"
+
+	dataSourceResourceIDPartCount = 3
+)
+
+type dataSourceResource struct {
+	framework.ResourceWithModel[dataSourceResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *dataSourceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The identifier of the Amazon Q application the data source is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrConfiguration: schema.StringAttribute{
+				CustomType:  fwtypes.NewSmithyJSONType(ctx, document.NewLazyDocument),
+				Description: "The connector configuration of the data source, as a JSON document.",
+				Required:    true,
+			},
+			"data_source_id": schema.StringAttribute{
+				Description: "The identifier of the data source.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description of the data source.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the data source.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The Amazon Resource Name (ARN) of the IAM role used by the data source connector to access the data source repository.",
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"index_id": schema.StringAttribute{
+				Description: "The identifier of the index the data source is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_on_create": schema.BoolAttribute{
+				Description: "Whether to start a data source sync job after the data source is created and wait for it to complete.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"sync_schedule": schema.StringAttribute{
+				Description: "The frequency at which the data source is synchronized with the index, as a cron expression. Leave empty to synchronize on demand.",
+				Optional:    true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				Description: "The type of the data source connector.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			names.AttrVPCConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[dataSourceVPCConfigurationModel](ctx),
+				Description: "Configuration of the VPC used by the data source connector.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrSecurityGroupIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "The identifiers of the security groups used by the data source connector.",
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeBetween(1, 10),
+							},
+						},
+						names.AttrSubnetIDs: schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Description: "The identifiers of the subnets used by the data source connector.",
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *dataSourceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data dataSourceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateDataSourceInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateDataSource(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameDataSource, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.DataSourceID = fwflex.StringToFramework(ctx, out.DataSourceId)
+	id, err := data.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionFlatteningResourceId, ResNameDataSource, data.DataSourceID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	applicationID, indexID, dataSourceID := data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString()
+	findOut, err := waitDataSourceActive(ctx, conn, applicationID, indexID, dataSourceID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameDataSource, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if data.SyncOnCreate.ValueBool() {
+		if err := startDataSourceSyncJob(ctx, conn, applicationID, indexID, dataSourceID, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameDataSource, id, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Set unknown values
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dataSourceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data dataSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	out, err := findDataSourceByThreePartKey(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameDataSource, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *dataSourceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan dataSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Configuration.Equal(plan.Configuration) ||
+		!state.Description.Equal(plan.Description) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.RoleARN.Equal(plan.RoleARN) ||
+		!state.SyncSchedule.Equal(plan.SyncSchedule) ||
+		!state.VPCConfiguration.Equal(plan.VPCConfiguration) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateDataSourceInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := plan.ID.ValueString()
+		if _, err := conn.UpdateDataSource(ctx, input); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameDataSource, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		findOut, err := waitDataSourceActive(ctx, conn, plan.ApplicationID.ValueString(), plan.IndexID.ValueString(), plan.DataSourceID.ValueString(), r.UpdateTimeout(ctx, plan.Timeouts))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNameDataSource, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		// Set unknown values
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *dataSourceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data dataSourceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	id := data.ID.ValueString()
+	applicationID, indexID, dataSourceID := data.ApplicationID.ValueString(), data.IndexID.ValueString(), data.DataSourceID.ValueString()
+	input := &qbusiness.DeleteDataSourceInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	if _, err := conn.DeleteDataSource(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameDataSource, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitDataSourceDeleted(ctx, conn, applicationID, indexID, dataSourceID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameDataSource, id, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func startDataSourceSyncJob(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) error {
+	input := &qbusiness.StartDataSourceSyncJobInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.StartDataSourceSyncJob(ctx, input)
+	if err != nil {
+		return err
+	}
+
+	executionID := aws.ToString(output.ExecutionId)
+	if _, err := waitDataSourceSyncJobCompleted(ctx, conn, applicationID, indexID, dataSourceID, executionID, timeout); err != nil {
+		return fmt.Errorf("waiting for sync job (%s) to complete: %w", executionID, err)
+	}
+
+	return nil
+}
+
+func findDataSourceByThreePartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) (*qbusiness.GetDataSourceOutput, error) {
+	input := &qbusiness.GetDataSourceInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetDataSource(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findDataSourceSyncJobByID(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID, executionID string) (*awstypes.DataSourceSyncJob, error) {
+	input := &qbusiness.ListDataSourceSyncJobsInput{
+		ApplicationId: aws.String(applicationID),
+		DataSourceId:  aws.String(dataSourceID),
+		IndexId:       aws.String(indexID),
+	}
+
+	paginator := qbusiness.NewListDataSourceSyncJobsPaginator(conn, input)
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.History {
+			if aws.ToString(v.ExecutionId) == executionID {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func statusDataSource(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDataSourceByThreePartKey(ctx, conn, applicationID, indexID, dataSourceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusDataSourceSyncJob(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID, executionID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDataSourceSyncJobByID(ctx, conn, applicationID, indexID, dataSourceID, executionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitDataSourceActive(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataSourceStatusPendingCreation, awstypes.DataSourceStatusCreating, awstypes.DataSourceStatusUpdating),
+		Target:     enum.Slice(awstypes.DataSourceStatusActive),
+		Refresh:    statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitDataSourceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID string, timeout time.Duration) (*qbusiness.GetDataSourceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataSourceStatusActive, awstypes.DataSourceStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusDataSource(ctx, conn, applicationID, indexID, dataSourceID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetDataSourceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitDataSourceSyncJobCompleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID, dataSourceID, executionID string, timeout time.Duration) (*awstypes.DataSourceSyncJob, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.DataSourceSyncJobStatusSyncing, awstypes.DataSourceSyncJobStatusSyncingIndexing),
+		Target:     enum.Slice(awstypes.DataSourceSyncJobStatusSucceeded, awstypes.DataSourceSyncJobStatusIncomplete),
+		Refresh:    statusDataSourceSyncJob(ctx, conn, applicationID, indexID, dataSourceID, executionID),
+		Timeout:    timeout,
+		MinTimeout: 30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.DataSourceSyncJob); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+type dataSourceResourceModel struct {
+	framework.WithRegionModel
+	ApplicationID    types.String                                                     `tfsdk:"application_id"`
+	Configuration    fwtypes.SmithyJSON[document.Interface]                           `tfsdk:"configuration"`
+	DataSourceARN    types.String                                                     `tfsdk:"arn"`
+	DataSourceID     types.String                                                     `tfsdk:"data_source_id"`
+	Description      types.String                                                     `tfsdk:"description"`
+	DisplayName      types.String                                                     `tfsdk:"display_name"`
+	ID               types.String                                                     `tfsdk:"id"`
+	IndexID          types.String                                                     `tfsdk:"index_id"`
+	RoleARN          fwtypes.ARN                                                      `tfsdk:"iam_service_role_arn"`
+	SyncOnCreate     types.Bool                                                       `tfsdk:"sync_on_create"`
+	SyncSchedule     types.String                                                     `tfsdk:"sync_schedule"`
+	Tags             tftags.Map                                                       `tfsdk:"tags"`
+	TagsAll          tftags.Map                                                       `tfsdk:"tags_all"`
+	Timeouts         timeouts.Value                                                   `tfsdk:"timeouts"`
+	Type             types.String                                                     `tfsdk:"type"`
+	VPCConfiguration fwtypes.ListNestedObjectValueOf[dataSourceVPCConfigurationModel] `tfsdk:"vpc_configuration"`
+}
+
+func (m *dataSourceResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), dataSourceResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.IndexID = types.StringValue(parts[1])
+	m.DataSourceID = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (m *dataSourceResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ApplicationID.ValueString(),
+		m.IndexID.ValueString(),
+		m.DataSourceID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, dataSourceResourceIDPartCount, false)
+}
+
+type dataSourceVPCConfigurationModel struct {
+	SecurityGroupIDs fwtypes.SetOfString `tfsdk:"security_group_ids"`
+	SubnetIDs        fwtypes.SetOfString `tfsdk:"subnet_ids"`
+}
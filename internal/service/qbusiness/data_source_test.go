@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfqbusiness "github.com/hashicorp/terraform-provider-aws/internal/service/qbusiness"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccQBusinessDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttrPair(resourceName, "application_id", "aws_qbusiness_application.test", names.AttrID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "data_source_id"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDisplayName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "iam_service_role_arn", "aws_iam_role.data_source", names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "index_id", "aws_qbusiness_index.test", "index_id"),
+					resource.TestCheckResourceAttr(resourceName, "sync_on_create", acctest.CtFalse),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "S3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"sync_on_create"},
+			},
+		},
+	})
+}
+
+func TestAccQBusinessDataSource_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfqbusiness.ResourceDataSource, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccQBusinessDataSource_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_update(rName, "description", "cron(0 12 * * ? *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description"),
+					resource.TestCheckResourceAttr(resourceName, "sync_schedule", "cron(0 12 * * ? *)"),
+				),
+			},
+			{
+				Config: testAccDataSourceConfig_update(rName, "description updated", "cron(0 18 * * ? *)"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description updated"),
+					resource.TestCheckResourceAttr(resourceName, "sync_schedule", "cron(0 18 * * ? *)"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccQBusinessDataSource_syncOnCreate(t *testing.T) {
+	ctx := acctest.Context(t)
+	var dataSource qbusiness.GetDataSourceOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_qbusiness_data_source.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheckApplication(ctx, t)
+			acctest.PreCheckSSOAdminInstances(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.QBusinessServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDataSourceDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceConfig_syncOnCreate(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDataSourceExists(ctx, resourceName, &dataSource),
+					resource.TestCheckResourceAttr(resourceName, "sync_on_create", acctest.CtTrue),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckDataSourceDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_qbusiness_data_source" {
+				continue
+			}
+
+			_, err := tfqbusiness.FindDataSourceByThreePartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["index_id"], rs.Primary.Attributes["data_source_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Amazon Q Data Source %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckDataSourceExists(ctx context.Context, n string, v *qbusiness.GetDataSourceOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).QBusinessClient(ctx)
+
+		output, err := tfqbusiness.FindDataSourceByThreePartKey(ctx, conn, rs.Primary.Attributes["application_id"], rs.Primary.Attributes["index_id"], rs.Primary.Attributes["data_source_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDataSourceConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccIndexConfig_basic(rName), fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_iam_role" "data_source" {
+  name = "%[1]s-data-source"
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action = "sts:AssumeRole"
+        Principal = {
+          Service = "qbusiness.${data.aws_partition.current.dns_suffix}"
+        }
+        Effect = "Allow"
+      }
+    ]
+  })
+}
+
+resource "aws_iam_role_policy" "data_source" {
+  role = aws_iam_role.data_source.id
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Action   = ["s3:GetObject", "s3:ListBucket"]
+        Effect   = "Allow"
+        Resource = [aws_s3_bucket.test.arn, "${aws_s3_bucket.test.arn}/*"]
+      },
+      {
+        Action = [
+          "qbusiness:BatchPutDocument",
+          "qbusiness:BatchDeleteDocument",
+          "qbusiness:PutGroup",
+          "qbusiness:CreateUser",
+          "qbusiness:DeleteGroup",
+          "qbusiness:UpdateUser",
+          "qbusiness:ListGroups",
+        ]
+        Effect   = "Allow"
+        Resource = "*"
+      },
+    ]
+  })
+}
+`, rName))
+}
+
+func testAccDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_qbusiness_data_source" "test" {
+  application_id       = aws_qbusiness_application.test.id
+  index_id             = aws_qbusiness_index.test.index_id
+  display_name         = %[1]q
+  iam_service_role_arn = aws_iam_role.data_source.arn
+
+  configuration = jsonencode({
+    type     = "S3"
+    syncMode = "FULL_CRAWL"
+    connectionConfiguration = {
+      repositoryEndpointMetadata = {
+        BucketName = aws_s3_bucket.test.bucket
+      }
+    }
+    repositoryConfigurations = {
+      document = {
+        fieldMappings = [
+          {
+            dataSourceFieldName = "s3_document_id"
+            indexFieldName      = "s3_document_id"
+            indexFieldType      = "STRING"
+          }
+        ]
+      }
+    }
+  })
+
+  depends_on = [aws_iam_role_policy.data_source]
+}
+`, rName))
+}
+
+func testAccDataSourceConfig_update(rName, description, syncSchedule string) string {
+	return acctest.ConfigCompose(testAccDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_qbusiness_data_source" "test" {
+  application_id       = aws_qbusiness_application.test.id
+  index_id             = aws_qbusiness_index.test.index_id
+  display_name         = %[1]q
+  description          = %[2]q
+  iam_service_role_arn = aws_iam_role.data_source.arn
+  sync_schedule        = %[3]q
+
+  configuration = jsonencode({
+    type     = "S3"
+    syncMode = "FULL_CRAWL"
+    connectionConfiguration = {
+      repositoryEndpointMetadata = {
+        BucketName = aws_s3_bucket.test.bucket
+      }
+    }
+    repositoryConfigurations = {
+      document = {
+        fieldMappings = [
+          {
+            dataSourceFieldName = "s3_document_id"
+            indexFieldName      = "s3_document_id"
+            indexFieldType      = "STRING"
+          }
+        ]
+      }
+    }
+  })
+
+  depends_on = [aws_iam_role_policy.data_source]
+}
+`, rName, description, syncSchedule))
+}
+
+func testAccDataSourceConfig_syncOnCreate(rName string) string {
+	return acctest.ConfigCompose(testAccDataSourceConfig_base(rName), fmt.Sprintf(`
+resource "aws_s3_object" "test" {
+  bucket  = aws_s3_bucket.test.bucket
+  key     = "test.txt"
+  content = %[1]q
+}
+
+resource "aws_qbusiness_data_source" "test" {
+  application_id       = aws_qbusiness_application.test.id
+  index_id             = aws_qbusiness_index.test.index_id
+  display_name         = %[1]q
+  iam_service_role_arn = aws_iam_role.data_source.arn
+  sync_on_create       = true
+
+  configuration = jsonencode({
+    type     = "S3"
+    syncMode = "FULL_CRAWL"
+    connectionConfiguration = {
+      repositoryEndpointMetadata = {
+        BucketName = aws_s3_bucket.test.bucket
+      }
+    }
+    repositoryConfigurations = {
+      document = {
+        fieldMappings = [
+          {
+            dataSourceFieldName = "s3_document_id"
+            indexFieldName      = "s3_document_id"
+            indexFieldType      = "STRING"
+          }
+        ]
+      }
+    }
+  })
+
+  depends_on = [aws_iam_role_policy.data_source, aws_s3_object.test]
+}
+`, rName))
+}
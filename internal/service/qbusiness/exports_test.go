@@ -4,7 +4,17 @@
 package qbusiness
 
 var (
-	ResourceApplication = newApplicationResource
+	ResourceApplication   = newApplicationResource
+	ResourceDataSource    = newDataSourceResource
+	ResourceIndex         = newIndexResource
+	ResourcePlugin        = newPluginResource
+	ResourceRetriever     = newRetrieverResource
+	ResourceWebExperience = newWebExperienceResource
 
-	FindApplicationByID = findApplicationByID
+	FindApplicationByID           = findApplicationByID
+	FindDataSourceByThreePartKey  = findDataSourceByThreePartKey
+	FindIndexByTwoPartKey         = findIndexByTwoPartKey
+	FindPluginByTwoPartKey        = findPluginByTwoPartKey
+	FindRetrieverByTwoPartKey     = findRetrieverByTwoPartKey
+	FindWebExperienceByTwoPartKey = findWebExperienceByTwoPartKey
 )
@@ -0,0 +1,552 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_index", name="Index")
+// @Tags(identifierAttribute="arn")
+func newIndexResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &indexResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameIndex = "Index"
+
+	indexResourceIDPartCount = 2
+)
+
+type indexResource struct {
+	framework.ResourceWithModel[indexResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *indexResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The identifier of the Amazon Q application the index is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"capacity_configuration": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[indexCapacityConfigurationModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[indexCapacityConfigurationModel](ctx),
+				Description: "The capacity units provisioned for the index.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "A description of the index.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(0, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the index.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"index_id": schema.StringAttribute{
+				Description: "The identifier of the index.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.IndexType](),
+				Description: "The index type. Valid values are `ENTERPRISE` and `STARTER`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"document_attribute_configuration": schema.SetNestedBlock{
+				CustomType:  fwtypes.NewSetNestedObjectTypeOf[documentAttributeConfigurationModel](ctx),
+				Description: "Configuration of document attributes that can be searched. Only the configured attributes are tracked.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Description: "The name of the document attribute.",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 30),
+							},
+						},
+						"search": schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.Status](),
+							Description: "Whether the document attribute is searchable. Valid values are `ENABLED` and `DISABLED`.",
+							Required:    true,
+						},
+						names.AttrType: schema.StringAttribute{
+							CustomType:  fwtypes.StringEnumType[awstypes.AttributeType](),
+							Description: "The type of the document attribute.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *indexResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data indexResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateIndexInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateIndex(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameIndex, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.IndexID = fwflex.StringToFramework(ctx, out.IndexId)
+	id, err := data.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionFlatteningResourceId, ResNameIndex, data.IndexID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	applicationID, indexID := data.ApplicationID.ValueString(), data.IndexID.ValueString()
+	if _, err := waitIndexActive(ctx, conn, applicationID, indexID, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Document attribute configurations can only be set once the index exists.
+	if !data.DocumentAttributeConfigurations.IsNull() {
+		if err := updateIndexDocumentAttributeConfigurations(ctx, conn, &data, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameIndex, id, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	findOut, err := findIndexByTwoPartKey(ctx, conn, applicationID, indexID)
+	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	resp.Diagnostics.Append(data.flatten(ctx, findOut)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *indexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data indexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	out, err := findIndexByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameIndex, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(data.flatten(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *indexResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan indexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.CapacityConfiguration.Equal(plan.CapacityConfiguration) ||
+		!state.Description.Equal(plan.Description) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.DocumentAttributeConfigurations.Equal(plan.DocumentAttributeConfigurations) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateIndexInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := plan.ID.ValueString()
+		if _, err := conn.UpdateIndex(ctx, input); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameIndex, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		applicationID, indexID := plan.ApplicationID.ValueString(), plan.IndexID.ValueString()
+		if _, err := waitIndexActive(ctx, conn, applicationID, indexID, r.UpdateTimeout(ctx, plan.Timeouts)); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNameIndex, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		findOut, err := findIndexByTwoPartKey(ctx, conn, applicationID, indexID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameIndex, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		// Set unknown values
+		resp.Diagnostics.Append(plan.flatten(ctx, findOut)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *indexResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data indexResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	id := data.ID.ValueString()
+	applicationID, indexID := data.ApplicationID.ValueString(), data.IndexID.ValueString()
+	input := &qbusiness.DeleteIndexInput{
+		ApplicationId: aws.String(applicationID),
+		IndexId:       aws.String(indexID),
+	}
+
+	if _, err := conn.DeleteIndex(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitIndexDeleted(ctx, conn, applicationID, indexID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameIndex, id, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func updateIndexDocumentAttributeConfigurations(ctx context.Context, conn *qbusiness.Client, data *indexResourceModel, timeout time.Duration) error {
+	input := &qbusiness.UpdateIndexInput{}
+	if diags := fwflex.Expand(ctx, data, input); diags.HasError() {
+		return fwdiag.DiagnosticsError(diags)
+	}
+
+	if _, err := conn.UpdateIndex(ctx, input); err != nil {
+		return err
+	}
+
+	if _, err := waitIndexActive(ctx, conn, data.ApplicationID.ValueString(), data.IndexID.ValueString(), timeout); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func findIndexByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string) (*qbusiness.GetIndexOutput, error) {
+	input := &qbusiness.GetIndexInput{
+		ApplicationId: aws.String(applicationID),
+		IndexId:       aws.String(indexID),
+	}
+
+	output, err := conn.GetIndex(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusIndex(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findIndexByTwoPartKey(ctx, conn, applicationID, indexID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitIndexActive(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) { //nolint:unparam
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.IndexStatusCreating, awstypes.IndexStatusUpdating),
+		Target:     enum.Slice(awstypes.IndexStatusActive),
+		Refresh:    statusIndex(ctx, conn, applicationID, indexID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitIndexDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, indexID string, timeout time.Duration) (*qbusiness.GetIndexOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.IndexStatusActive, awstypes.IndexStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusIndex(ctx, conn, applicationID, indexID),
+		Timeout:    timeout,
+		MinTimeout: 10 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetIndexOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+type indexResourceModel struct {
+	framework.WithRegionModel
+	ApplicationID                   types.String                                                        `tfsdk:"application_id"`
+	CapacityConfiguration           fwtypes.ListNestedObjectValueOf[indexCapacityConfigurationModel]    `tfsdk:"capacity_configuration"`
+	Description                     types.String                                                        `tfsdk:"description"`
+	DisplayName                     types.String                                                        `tfsdk:"display_name"`
+	DocumentAttributeConfigurations fwtypes.SetNestedObjectValueOf[documentAttributeConfigurationModel] `tfsdk:"document_attribute_configuration"`
+	ID                              types.String                                                        `tfsdk:"id"`
+	IndexARN                        types.String                                                        `tfsdk:"arn"`
+	IndexID                         types.String                                                        `tfsdk:"index_id"`
+	Tags                            tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                         tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                        timeouts.Value                                                      `tfsdk:"timeouts"`
+	Type                            fwtypes.StringEnum[awstypes.IndexType]                              `tfsdk:"type"`
+}
+
+func (m *indexResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), indexResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.IndexID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *indexResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ApplicationID.ValueString(),
+		m.IndexID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, indexResourceIDPartCount, false)
+}
+
+// flatten sets the model from the API response.
+// The service returns every built-in document attribute, so only the document
+// attribute configurations already present in the model are kept.
+func (m *indexResourceModel) flatten(ctx context.Context, out *qbusiness.GetIndexOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	configured, d := m.DocumentAttributeConfigurations.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	configuredNames := make(map[string]struct{}, len(configured))
+	for _, v := range configured {
+		configuredNames[v.Name.ValueString()] = struct{}{}
+	}
+
+	output := *out
+	output.DocumentAttributeConfigurations = nil
+	for _, v := range out.DocumentAttributeConfigurations {
+		if _, ok := configuredNames[aws.ToString(v.Name)]; ok {
+			output.DocumentAttributeConfigurations = append(output.DocumentAttributeConfigurations, v)
+		}
+	}
+
+	diags.Append(fwflex.Flatten(ctx, &output, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if len(output.DocumentAttributeConfigurations) == 0 {
+		m.DocumentAttributeConfigurations = fwtypes.NewSetNestedObjectValueOfNull[documentAttributeConfigurationModel](ctx)
+	}
+
+	return diags
+}
+
+type indexCapacityConfigurationModel struct {
+	Units types.Int32 `tfsdk:"units"`
+}
+
+type documentAttributeConfigurationModel struct {
+	Name   types.String                               `tfsdk:"name"`
+	Search fwtypes.StringEnum[awstypes.Status]        `tfsdk:"search"`
+	Type   fwtypes.StringEnum[awstypes.AttributeType] `tfsdk:"type"`
+}
@@ -0,0 +1,803 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_plugin", name="Plugin")
+// @Tags(identifierAttribute="arn")
+func newPluginResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &pluginResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNamePlugin = "Plugin"
+
+	pluginResourceIDPartCount = 2
+)
+
+type pluginResource struct {
+	framework.ResourceWithModel[pluginResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *pluginResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	authConfigurationPaths := []path.Expression{
+		path.MatchRelative().AtParent().AtName("basic_auth_configuration"),
+		path.MatchRelative().AtParent().AtName("idc_auth_configuration"),
+		path.MatchRelative().AtParent().AtName("no_auth_configuration"),
+		path.MatchRelative().AtParent().AtName("oauth2_client_credential_configuration"),
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The identifier of the Amazon Q application the plugin is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"build_status": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.PluginBuildStatus](),
+				Description: "The current build status of the plugin.",
+				Computed:    true,
+			},
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the plugin.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 100),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"plugin_id": schema.StringAttribute{
+				Description: "The identifier of the plugin.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"server_url": schema.StringAttribute{
+				Description: "The source URL used for plugin configuration. Required for all plugin types except `CUSTOM`.",
+				Optional:    true,
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.PluginState](),
+				Description: "The state of the plugin. Valid values are `ENABLED` and `DISABLED`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.PluginType](),
+				Description: "The type of the plugin.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"auth_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[pluginAuthConfigurationModel](ctx),
+				Description: "Authentication configuration used by the plugin.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"basic_auth_configuration": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[basicAuthConfigurationModel](ctx),
+							Description: "Basic authentication credentials used by the plugin.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(authConfigurationPaths...),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"secret_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"idc_auth_configuration": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[idcAuthConfigurationModel](ctx),
+							Description: "IAM Identity Center authentication used by the plugin.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"idc_application_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"no_auth_configuration": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[noAuthConfigurationModel](ctx),
+							Description: "Use no authentication for the plugin.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{},
+						},
+						"oauth2_client_credential_configuration": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[oauth2ClientCredentialConfigurationModel](ctx),
+							Description: "OAuth 2.0 client credentials used by the plugin.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authorization_url": schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrRoleARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"secret_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"token_url": schema.StringAttribute{
+										Optional: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"custom_plugin_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customPluginConfigurationModel](ctx),
+				Description: "Configuration of a `CUSTOM` plugin.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"api_schema_type": schema.StringAttribute{
+							CustomType: fwtypes.StringEnumType[awstypes.APISchemaType](),
+							Required:   true,
+						},
+						names.AttrDescription: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 200),
+							},
+						},
+					},
+					Blocks: map[string]schema.Block{
+						"api_schema": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[apiSchemaModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"payload": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("s3")),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"s3": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[s3Model](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrBucket: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrKey: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *pluginResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data pluginResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreatePluginInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreatePlugin(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNamePlugin, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.PluginID = fwflex.StringToFramework(ctx, out.PluginId)
+	id, err := data.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionFlatteningResourceId, ResNamePlugin, data.PluginID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	applicationID, pluginID := data.ApplicationID.ValueString(), data.PluginID.ValueString()
+	findOut, err := waitPluginReady(ctx, conn, applicationID, pluginID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNamePlugin, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// The plugin state can only be set on update.
+	if state := data.State.ValueEnum(); state != "" && state != findOut.State {
+		input := &qbusiness.UpdatePluginInput{
+			ApplicationId: aws.String(applicationID),
+			PluginId:      aws.String(pluginID),
+			State:         state,
+		}
+
+		if _, err := conn.UpdatePlugin(ctx, input); err != nil {
+			resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNamePlugin, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		findOut, err = waitPluginReady(ctx, conn, applicationID, pluginID, r.CreateTimeout(ctx, data.Timeouts))
+		if err != nil {
+			resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNamePlugin, id, err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	// Set unknown values
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data pluginResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	out, err := findPluginByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.PluginID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNamePlugin, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *pluginResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan pluginResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.AuthConfiguration.Equal(plan.AuthConfiguration) ||
+		!state.CustomPluginConfiguration.Equal(plan.CustomPluginConfiguration) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.ServerURL.Equal(plan.ServerURL) ||
+		!state.State.Equal(plan.State) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdatePluginInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := plan.ID.ValueString()
+		if _, err := conn.UpdatePlugin(ctx, input); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNamePlugin, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		findOut, err := waitPluginReady(ctx, conn, plan.ApplicationID.ValueString(), plan.PluginID.ValueString(), r.UpdateTimeout(ctx, plan.Timeouts))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNamePlugin, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		// Set unknown values
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *pluginResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data pluginResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	id := data.ID.ValueString()
+	applicationID, pluginID := data.ApplicationID.ValueString(), data.PluginID.ValueString()
+	input := &qbusiness.DeletePluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	if _, err := conn.DeletePlugin(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNamePlugin, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitPluginDeleted(ctx, conn, applicationID, pluginID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNamePlugin, id, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findPluginByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) (*qbusiness.GetPluginOutput, error) {
+	input := &qbusiness.GetPluginInput{
+		ApplicationId: aws.String(applicationID),
+		PluginId:      aws.String(pluginID),
+	}
+
+	output, err := conn.GetPlugin(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusPlugin(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findPluginByTwoPartKey(ctx, conn, applicationID, pluginID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.BuildStatus), nil
+	}
+}
+
+func waitPluginReady(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PluginBuildStatusCreateInProgress, awstypes.PluginBuildStatusUpdateInProgress),
+		Target:     enum.Slice(awstypes.PluginBuildStatusReady),
+		Refresh:    statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		tfresource.SetLastError(err, errors.New(string(output.BuildStatus)))
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitPluginDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, pluginID string, timeout time.Duration) (*qbusiness.GetPluginOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.PluginBuildStatusReady, awstypes.PluginBuildStatusDeleteInProgress),
+		Target:     []string{},
+		Refresh:    statusPlugin(ctx, conn, applicationID, pluginID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetPluginOutput); ok {
+		tfresource.SetLastError(err, errors.New(string(output.BuildStatus)))
+
+		return output, err
+	}
+	return nil, err
+}
+
+type pluginResourceModel struct {
+	framework.WithRegionModel
+	ApplicationID             types.String                                                    `tfsdk:"application_id"`
+	AuthConfiguration         fwtypes.ListNestedObjectValueOf[pluginAuthConfigurationModel]   `tfsdk:"auth_configuration"`
+	BuildStatus               fwtypes.StringEnum[awstypes.PluginBuildStatus]                  `tfsdk:"build_status"`
+	CustomPluginConfiguration fwtypes.ListNestedObjectValueOf[customPluginConfigurationModel] `tfsdk:"custom_plugin_configuration"`
+	DisplayName               types.String                                                    `tfsdk:"display_name"`
+	ID                        types.String                                                    `tfsdk:"id"`
+	PluginARN                 types.String                                                    `tfsdk:"arn"`
+	PluginID                  types.String                                                    `tfsdk:"plugin_id"`
+	ServerURL                 types.String                                                    `tfsdk:"server_url"`
+	State                     fwtypes.StringEnum[awstypes.PluginState]                        `tfsdk:"state"`
+	Tags                      tftags.Map                                                      `tfsdk:"tags"`
+	TagsAll                   tftags.Map                                                      `tfsdk:"tags_all"`
+	Timeouts                  timeouts.Value                                                  `tfsdk:"timeouts"`
+	Type                      fwtypes.StringEnum[awstypes.PluginType]                         `tfsdk:"type"`
+}
+
+func (m *pluginResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), pluginResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.PluginID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *pluginResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ApplicationID.ValueString(),
+		m.PluginID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, pluginResourceIDPartCount, false)
+}
+
+type pluginAuthConfigurationModel struct {
+	BasicAuthConfiguration              fwtypes.ListNestedObjectValueOf[basicAuthConfigurationModel]              `tfsdk:"basic_auth_configuration"`
+	IDCAuthConfiguration                fwtypes.ListNestedObjectValueOf[idcAuthConfigurationModel]                `tfsdk:"idc_auth_configuration"`
+	NoAuthConfiguration                 fwtypes.ListNestedObjectValueOf[noAuthConfigurationModel]                 `tfsdk:"no_auth_configuration"`
+	OAuth2ClientCredentialConfiguration fwtypes.ListNestedObjectValueOf[oauth2ClientCredentialConfigurationModel] `tfsdk:"oauth2_client_credential_configuration"`
+}
+
+var (
+	_ fwflex.Expander  = pluginAuthConfigurationModel{}
+	_ fwflex.Flattener = &pluginAuthConfigurationModel{}
+)
+
+func (m pluginAuthConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.BasicAuthConfiguration.IsNull():
+		basicAuthConfigurationData, d := m.BasicAuthConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration
+		diags.Append(fwflex.Expand(ctx, basicAuthConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.IDCAuthConfiguration.IsNull():
+		idcAuthConfigurationData, d := m.IDCAuthConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PluginAuthConfigurationMemberIdcAuthConfiguration
+		diags.Append(fwflex.Expand(ctx, idcAuthConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.NoAuthConfiguration.IsNull():
+		return &awstypes.PluginAuthConfigurationMemberNoAuthConfiguration{}, diags
+
+	case !m.OAuth2ClientCredentialConfiguration.IsNull():
+		oauth2ClientCredentialConfigurationData, d := m.OAuth2ClientCredentialConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration
+		diags.Append(fwflex.Expand(ctx, oauth2ClientCredentialConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *pluginAuthConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.PluginAuthConfigurationMemberBasicAuthConfiguration:
+		var model basicAuthConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.BasicAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.PluginAuthConfigurationMemberIdcAuthConfiguration:
+		var model idcAuthConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.IDCAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.PluginAuthConfigurationMemberNoAuthConfiguration:
+		m.NoAuthConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &noAuthConfigurationModel{})
+
+		return diags
+
+	case awstypes.PluginAuthConfigurationMemberOAuth2ClientCredentialConfiguration:
+		var model oauth2ClientCredentialConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.OAuth2ClientCredentialConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type basicAuthConfigurationModel struct {
+	RoleARN   fwtypes.ARN `tfsdk:"role_arn"`
+	SecretARN fwtypes.ARN `tfsdk:"secret_arn"`
+}
+
+type idcAuthConfigurationModel struct {
+	IDCApplicationARN fwtypes.ARN `tfsdk:"idc_application_arn"`
+	RoleARN           fwtypes.ARN `tfsdk:"role_arn"`
+}
+
+type noAuthConfigurationModel struct{}
+
+type oauth2ClientCredentialConfigurationModel struct {
+	AuthorizationURL types.String `tfsdk:"authorization_url"`
+	RoleARN          fwtypes.ARN  `tfsdk:"role_arn"`
+	SecretARN        fwtypes.ARN  `tfsdk:"secret_arn"`
+	TokenURL         types.String `tfsdk:"token_url"`
+}
+
+type customPluginConfigurationModel struct {
+	APISchema     fwtypes.ListNestedObjectValueOf[apiSchemaModel] `tfsdk:"api_schema"`
+	APISchemaType fwtypes.StringEnum[awstypes.APISchemaType]      `tfsdk:"api_schema_type"`
+	Description   types.String                                    `tfsdk:"description"`
+}
+
+type apiSchemaModel struct {
+	Payload types.String                             `tfsdk:"payload"`
+	S3      fwtypes.ListNestedObjectValueOf[s3Model] `tfsdk:"s3"`
+}
+
+var (
+	_ fwflex.Expander  = apiSchemaModel{}
+	_ fwflex.Flattener = &apiSchemaModel{}
+)
+
+func (m apiSchemaModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.Payload.IsNull():
+		return &awstypes.APISchemaMemberPayload{Value: m.Payload.ValueString()}, diags
+
+	case !m.S3.IsNull():
+		s3Data, d := m.S3.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.APISchemaMemberS3
+		diags.Append(fwflex.Expand(ctx, s3Data, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *apiSchemaModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.APISchemaMemberPayload:
+		m.Payload = types.StringValue(t.Value)
+
+		return diags
+
+	case awstypes.APISchemaMemberS3:
+		var model s3Model
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.S3 = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type s3Model struct {
+	Bucket types.String `tfsdk:"bucket"`
+	Key    types.String `tfsdk:"key"`
+}
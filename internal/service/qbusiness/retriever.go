@@ -0,0 +1,537 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_retriever", name="Retriever")
+// @Tags(identifierAttribute="arn")
+func newRetrieverResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &retrieverResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameRetriever = "Retriever"
+
+	retrieverResourceIDPartCount = 2
+)
+
+type retrieverResource struct {
+	framework.ResourceWithModel[retrieverResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *retrieverResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	indexIDAttribute := schema.StringAttribute{
+		Description: "The identifier of the index.",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.LengthBetween(1, 36),
+		},
+	}
+
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The identifier of the Amazon Q application the retriever is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDisplayName: schema.StringAttribute{
+				Description: "The display name of the retriever.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^\P{C}*$`), "must not contain control characters"),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The Amazon Resource Name (ARN) of the IAM role used by Amazon Q to access the retriever's index. Required for `KENDRA_INDEX` retrievers.",
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"retriever_id": schema.StringAttribute{
+				Description: "The identifier of the retriever.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrType: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.RetrieverType](),
+				Description: "The type of the retriever. Valid values are `NATIVE_INDEX` and `KENDRA_INDEX`.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrConfiguration: schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[retrieverConfigurationModel](ctx),
+				Description: "Configuration of the index used by the retriever.",
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"kendra_index_configuration": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[kendraIndexConfigurationModel](ctx),
+							Description: "Configuration of an Amazon Kendra index.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("kendra_index_configuration"),
+									path.MatchRelative().AtParent().AtName("native_index_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"index_id": indexIDAttribute,
+								},
+							},
+						},
+						"native_index_configuration": schema.ListNestedBlock{
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[nativeIndexConfigurationModel](ctx),
+							Description: "Configuration of an Amazon Q Business index.",
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"index_id": indexIDAttribute,
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *retrieverResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data retrieverResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateRetrieverInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateRetriever(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameRetriever, data.DisplayName.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.RetrieverID = fwflex.StringToFramework(ctx, out.RetrieverId)
+	id, err := data.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionFlatteningResourceId, ResNameRetriever, data.RetrieverID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	applicationID, retrieverID := data.ApplicationID.ValueString(), data.RetrieverID.ValueString()
+	findOut, err := waitRetrieverActive(ctx, conn, applicationID, retrieverID, r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameRetriever, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *retrieverResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data retrieverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	out, err := findRetrieverByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.RetrieverID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameRetriever, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *retrieverResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan retrieverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.Configuration.Equal(plan.Configuration) ||
+		!state.DisplayName.Equal(plan.DisplayName) ||
+		!state.RoleARN.Equal(plan.RoleARN) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateRetrieverInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := plan.ID.ValueString()
+		if _, err := conn.UpdateRetriever(ctx, input); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameRetriever, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		findOut, err := waitRetrieverActive(ctx, conn, plan.ApplicationID.ValueString(), plan.RetrieverID.ValueString(), r.UpdateTimeout(ctx, plan.Timeouts))
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForUpdate, ResNameRetriever, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		// Set unknown values
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *retrieverResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data retrieverResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	id := data.ID.ValueString()
+	applicationID, retrieverID := data.ApplicationID.ValueString(), data.RetrieverID.ValueString()
+	input := &qbusiness.DeleteRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	}
+
+	if _, err := conn.DeleteRetriever(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameRetriever, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitRetrieverDeleted(ctx, conn, applicationID, retrieverID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameRetriever, id, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findRetrieverByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) (*qbusiness.GetRetrieverOutput, error) {
+	input := &qbusiness.GetRetrieverInput{
+		ApplicationId: aws.String(applicationID),
+		RetrieverId:   aws.String(retrieverID),
+	}
+
+	output, err := conn.GetRetriever(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusRetriever(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findRetrieverByTwoPartKey(ctx, conn, applicationID, retrieverID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitRetrieverActive(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.RetrieverStatusCreating),
+		Target:     enum.Slice(awstypes.RetrieverStatusActive),
+		Refresh:    statusRetriever(ctx, conn, applicationID, retrieverID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+func waitRetrieverDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, retrieverID string, timeout time.Duration) (*qbusiness.GetRetrieverOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.RetrieverStatusActive, awstypes.RetrieverStatusCreating, awstypes.RetrieverStatusFailed),
+		Target:     []string{},
+		Refresh:    statusRetriever(ctx, conn, applicationID, retrieverID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetRetrieverOutput); ok {
+		return output, err
+	}
+	return nil, err
+}
+
+type retrieverResourceModel struct {
+	framework.WithRegionModel
+	ApplicationID types.String                                                 `tfsdk:"application_id"`
+	Configuration fwtypes.ListNestedObjectValueOf[retrieverConfigurationModel] `tfsdk:"configuration"`
+	DisplayName   types.String                                                 `tfsdk:"display_name"`
+	ID            types.String                                                 `tfsdk:"id"`
+	RetrieverARN  types.String                                                 `tfsdk:"arn"`
+	RetrieverID   types.String                                                 `tfsdk:"retriever_id"`
+	RoleARN       fwtypes.ARN                                                  `tfsdk:"iam_service_role_arn"`
+	Tags          tftags.Map                                                   `tfsdk:"tags"`
+	TagsAll       tftags.Map                                                   `tfsdk:"tags_all"`
+	Timeouts      timeouts.Value                                               `tfsdk:"timeouts"`
+	Type          fwtypes.StringEnum[awstypes.RetrieverType]                   `tfsdk:"type"`
+}
+
+func (m *retrieverResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), retrieverResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.RetrieverID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *retrieverResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ApplicationID.ValueString(),
+		m.RetrieverID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, retrieverResourceIDPartCount, false)
+}
+
+type retrieverConfigurationModel struct {
+	KendraIndexConfiguration fwtypes.ListNestedObjectValueOf[kendraIndexConfigurationModel] `tfsdk:"kendra_index_configuration"`
+	NativeIndexConfiguration fwtypes.ListNestedObjectValueOf[nativeIndexConfigurationModel] `tfsdk:"native_index_configuration"`
+}
+
+var (
+	_ fwflex.Expander  = retrieverConfigurationModel{}
+	_ fwflex.Flattener = &retrieverConfigurationModel{}
+)
+
+func (m retrieverConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.KendraIndexConfiguration.IsNull():
+		kendraIndexConfigurationData, d := m.KendraIndexConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RetrieverConfigurationMemberKendraIndexConfiguration
+		diags.Append(fwflex.Expand(ctx, kendraIndexConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.NativeIndexConfiguration.IsNull():
+		nativeIndexConfigurationData, d := m.NativeIndexConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.RetrieverConfigurationMemberNativeIndexConfiguration
+		diags.Append(fwflex.Expand(ctx, nativeIndexConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *retrieverConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.RetrieverConfigurationMemberKendraIndexConfiguration:
+		var model kendraIndexConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.KendraIndexConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.RetrieverConfigurationMemberNativeIndexConfiguration:
+		var model nativeIndexConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.NativeIndexConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type kendraIndexConfigurationModel struct {
+	IndexID types.String `tfsdk:"index_id"`
+}
+
+type nativeIndexConfigurationModel struct {
+	IndexID types.String `tfsdk:"index_id"`
+}
@@ -0,0 +1,644 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package qbusiness
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/qbusiness"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/qbusiness/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_qbusiness_web_experience", name="Web Experience")
+// @Tags(identifierAttribute="arn")
+func newWebExperienceResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &webExperienceResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameWebExperience = "Web Experience"
+
+	webExperienceResourceIDPartCount = 2
+)
+
+type webExperienceResource struct {
+	framework.ResourceWithModel[webExperienceResourceModel]
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *webExperienceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"application_id": schema.StringAttribute{
+				Description: "The identifier of the Amazon Q application the web experience is attached to.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"default_endpoint": schema.StringAttribute{
+				Description: "The endpoint of the web experience.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"iam_service_role_arn": schema.StringAttribute{
+				CustomType:  fwtypes.ARNType,
+				Description: "The Amazon Resource Name (ARN) of the service role attached to the web experience.",
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"origins": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Description: "The allowed website domain origins that can embed the web experience.",
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(10),
+				},
+			},
+			"sample_prompts_control_mode": schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.WebExperienceSamplePromptsControlMode](),
+				Description: "Whether sample prompts are enabled in the web experience. Valid values are `ENABLED` and `DISABLED`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType:  fwtypes.StringEnumType[awstypes.WebExperienceStatus](),
+				Description: "The status of the web experience.",
+				Computed:    true,
+			},
+			"subtitle": schema.StringAttribute{
+				Description: "The subtitle of the web experience.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"title": schema.StringAttribute{
+				Description: "The title of the web experience.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"web_experience_id": schema.StringAttribute{
+				Description: "The identifier of the web experience.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"welcome_message": schema.StringAttribute{
+				Description: "The welcome message displayed to users of the web experience.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(300),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"browser_extension_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[browserExtensionConfigurationModel](ctx),
+				Description: "Browser extensions enabled for the web experience.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"enabled_browser_extensions": schema.SetAttribute{
+							CustomType:  fwtypes.NewSetTypeOf[fwtypes.StringEnum[awstypes.BrowserExtension]](ctx),
+							ElementType: fwtypes.StringEnumType[awstypes.BrowserExtension](),
+							Required:    true,
+						},
+					},
+				},
+			},
+			"customization_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[customizationConfigurationModel](ctx),
+				Description: "Custom branding of the web experience.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"custom_css_url": schema.StringAttribute{
+							Optional: true,
+						},
+						"favicon_url": schema.StringAttribute{
+							Optional: true,
+						},
+						"font_url": schema.StringAttribute{
+							Optional: true,
+						},
+						"logo_url": schema.StringAttribute{
+							Optional: true,
+						},
+					},
+				},
+			},
+			"identity_provider_configuration": schema.ListNestedBlock{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[identityProviderConfigurationModel](ctx),
+				Description: "Identity provider used to authenticate users of the web experience, for applications that use IAM federation.",
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"open_id_connect_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[openIDConnectProviderConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+								listvalidator.ExactlyOneOf(
+									path.MatchRelative().AtParent().AtName("open_id_connect_configuration"),
+									path.MatchRelative().AtParent().AtName("saml_configuration"),
+								),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"secrets_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+									"secrets_role": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+						"saml_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[samlProviderConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"authentication_url": schema.StringAttribute{
+										Required: true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *webExperienceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var data webExperienceResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	input := &qbusiness.CreateWebExperienceInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	input.Tags = getTagsIn(ctx)
+	input.ClientToken = aws.String(id.UniqueId())
+
+	out, err := conn.CreateWebExperience(ctx, input)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionCreating, ResNameWebExperience, data.ApplicationID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	data.WebExperienceID = fwflex.StringToFramework(ctx, out.WebExperienceId)
+	id, err := data.setID()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionFlatteningResourceId, ResNameWebExperience, data.WebExperienceID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+	data.ID = types.StringValue(id)
+
+	findOut, err := waitWebExperienceCreated(ctx, conn, data.ApplicationID.ValueString(), data.WebExperienceID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+	if err != nil {
+		resp.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForCreation, ResNameWebExperience, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	// Set unknown values
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *webExperienceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var data webExperienceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	out, err := findWebExperienceByTwoPartKey(ctx, conn, data.ApplicationID.ValueString(), data.WebExperienceID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionReading, ResNameWebExperience, data.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(fwflex.Flatten(ctx, out, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *webExperienceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var state, plan webExperienceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !state.BrowserExtensionConfiguration.Equal(plan.BrowserExtensionConfiguration) ||
+		!state.CustomizationConfiguration.Equal(plan.CustomizationConfiguration) ||
+		!state.IdentityProviderConfiguration.Equal(plan.IdentityProviderConfiguration) ||
+		!state.Origins.Equal(plan.Origins) ||
+		!state.RoleARN.Equal(plan.RoleARN) ||
+		!state.SamplePromptsControlMode.Equal(plan.SamplePromptsControlMode) ||
+		!state.Subtitle.Equal(plan.Subtitle) ||
+		!state.Title.Equal(plan.Title) ||
+		!state.WelcomeMessage.Equal(plan.WelcomeMessage) {
+		conn := r.Meta().QBusinessClient(ctx)
+
+		input := &qbusiness.UpdateWebExperienceInput{}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, input)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		id := plan.ID.ValueString()
+		if _, err := conn.UpdateWebExperience(ctx, input); err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameWebExperience, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		findOut, err := findWebExperienceByTwoPartKey(ctx, conn, plan.ApplicationID.ValueString(), plan.WebExperienceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.QBusiness, create.ErrActionUpdating, ResNameWebExperience, id, err),
+				err.Error(),
+			)
+			return
+		}
+
+		// Set unknown values
+		resp.Diagnostics.Append(fwflex.Flatten(ctx, findOut, &plan)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.Status = state.Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *webExperienceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var data webExperienceResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	conn := r.Meta().QBusinessClient(ctx)
+
+	id := data.ID.ValueString()
+	applicationID, webExperienceID := data.ApplicationID.ValueString(), data.WebExperienceID.ValueString()
+	input := &qbusiness.DeleteWebExperienceInput{
+		ApplicationId:   aws.String(applicationID),
+		WebExperienceId: aws.String(webExperienceID),
+	}
+
+	if _, err := conn.DeleteWebExperience(ctx, input); err != nil {
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionDeleting, ResNameWebExperience, id, err),
+			err.Error(),
+		)
+		return
+	}
+
+	if _, err := waitWebExperienceDeleted(ctx, conn, applicationID, webExperienceID, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.QBusiness, create.ErrActionWaitingForDeletion, ResNameWebExperience, id, err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func findWebExperienceByTwoPartKey(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string) (*qbusiness.GetWebExperienceOutput, error) {
+	input := &qbusiness.GetWebExperienceInput{
+		ApplicationId:   aws.String(applicationID),
+		WebExperienceId: aws.String(webExperienceID),
+	}
+
+	output, err := conn.GetWebExperience(ctx, input)
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusWebExperience(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findWebExperienceByTwoPartKey(ctx, conn, applicationID, webExperienceID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitWebExperienceCreated(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.WebExperienceStatusCreating),
+		Target:     enum.Slice(awstypes.WebExperienceStatusActive, awstypes.WebExperienceStatusPendingAuthConfig),
+		Refresh:    statusWebExperience(ctx, conn, applicationID, webExperienceID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+func waitWebExperienceDeleted(ctx context.Context, conn *qbusiness.Client, applicationID, webExperienceID string, timeout time.Duration) (*qbusiness.GetWebExperienceOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:    enum.Slice(awstypes.WebExperienceStatusActive, awstypes.WebExperienceStatusPendingAuthConfig, awstypes.WebExperienceStatusDeleting),
+		Target:     []string{},
+		Refresh:    statusWebExperience(ctx, conn, applicationID, webExperienceID),
+		Timeout:    timeout,
+		MinTimeout: 5 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*qbusiness.GetWebExperienceOutput); ok {
+		if output.Error != nil {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Error.ErrorMessage)))
+		}
+
+		return output, err
+	}
+	return nil, err
+}
+
+type webExperienceResourceModel struct {
+	framework.WithRegionModel
+	ApplicationID                 types.String                                                        `tfsdk:"application_id"`
+	BrowserExtensionConfiguration fwtypes.ListNestedObjectValueOf[browserExtensionConfigurationModel] `tfsdk:"browser_extension_configuration"`
+	CustomizationConfiguration    fwtypes.ListNestedObjectValueOf[customizationConfigurationModel]    `tfsdk:"customization_configuration"`
+	DefaultEndpoint               types.String                                                        `tfsdk:"default_endpoint"`
+	ID                            types.String                                                        `tfsdk:"id"`
+	IdentityProviderConfiguration fwtypes.ListNestedObjectValueOf[identityProviderConfigurationModel] `tfsdk:"identity_provider_configuration"`
+	Origins                       fwtypes.SetOfString                                                 `tfsdk:"origins"`
+	RoleARN                       fwtypes.ARN                                                         `tfsdk:"iam_service_role_arn"`
+	SamplePromptsControlMode      fwtypes.StringEnum[awstypes.WebExperienceSamplePromptsControlMode]  `tfsdk:"sample_prompts_control_mode"`
+	Status                        fwtypes.StringEnum[awstypes.WebExperienceStatus]                    `tfsdk:"status"`
+	Subtitle                      types.String                                                        `tfsdk:"subtitle"`
+	Tags                          tftags.Map                                                          `tfsdk:"tags"`
+	TagsAll                       tftags.Map                                                          `tfsdk:"tags_all"`
+	Timeouts                      timeouts.Value                                                      `tfsdk:"timeouts"`
+	Title                         types.String                                                        `tfsdk:"title"`
+	WebExperienceARN              types.String                                                        `tfsdk:"arn"`
+	WebExperienceID               types.String                                                        `tfsdk:"web_experience_id"`
+	WelcomeMessage                types.String                                                        `tfsdk:"welcome_message"`
+}
+
+func (m *webExperienceResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), webExperienceResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.ApplicationID = types.StringValue(parts[0])
+	m.WebExperienceID = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *webExperienceResourceModel) setID() (string, error) {
+	parts := []string{
+		m.ApplicationID.ValueString(),
+		m.WebExperienceID.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, webExperienceResourceIDPartCount, false)
+}
+
+type browserExtensionConfigurationModel struct {
+	EnabledBrowserExtensions fwtypes.SetValueOf[fwtypes.StringEnum[awstypes.BrowserExtension]] `tfsdk:"enabled_browser_extensions"`
+}
+
+type customizationConfigurationModel struct {
+	CustomCSSURL types.String `tfsdk:"custom_css_url"`
+	FaviconURL   types.String `tfsdk:"favicon_url"`
+	FontURL      types.String `tfsdk:"font_url"`
+	LogoURL      types.String `tfsdk:"logo_url"`
+}
+
+type identityProviderConfigurationModel struct {
+	OpenIDConnectConfiguration fwtypes.ListNestedObjectValueOf[openIDConnectProviderConfigurationModel] `tfsdk:"open_id_connect_configuration"`
+	SAMLConfiguration          fwtypes.ListNestedObjectValueOf[samlProviderConfigurationModel]          `tfsdk:"saml_configuration"`
+}
+
+var (
+	_ fwflex.Expander  = identityProviderConfigurationModel{}
+	_ fwflex.Flattener = &identityProviderConfigurationModel{}
+)
+
+func (m identityProviderConfigurationModel) Expand(ctx context.Context) (result any, diags diag.Diagnostics) {
+	switch {
+	case !m.OpenIDConnectConfiguration.IsNull():
+		openIDConnectConfigurationData, d := m.OpenIDConnectConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IdentityProviderConfigurationMemberOpenIDConnectConfiguration
+		diags.Append(fwflex.Expand(ctx, openIDConnectConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+
+	case !m.SAMLConfiguration.IsNull():
+		samlConfigurationData, d := m.SAMLConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var r awstypes.IdentityProviderConfigurationMemberSamlConfiguration
+		diags.Append(fwflex.Expand(ctx, samlConfigurationData, &r.Value)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &r, diags
+	}
+
+	return nil, diags
+}
+
+func (m *identityProviderConfigurationModel) Flatten(ctx context.Context, v any) (diags diag.Diagnostics) {
+	switch t := v.(type) {
+	case awstypes.IdentityProviderConfigurationMemberOpenIDConnectConfiguration:
+		var model openIDConnectProviderConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.OpenIDConnectConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+
+	case awstypes.IdentityProviderConfigurationMemberSamlConfiguration:
+		var model samlProviderConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, t.Value, &model)...)
+		if diags.HasError() {
+			return diags
+		}
+
+		m.SAMLConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &model)
+
+		return diags
+	}
+
+	return diags
+}
+
+type openIDConnectProviderConfigurationModel struct {
+	SecretsARN  fwtypes.ARN `tfsdk:"secrets_arn"`
+	SecretsRole fwtypes.ARN `tfsdk:"secrets_role"`
+}
+
+type samlProviderConfigurationModel struct {
+	AuthenticationURL types.String `tfsdk:"authentication_url"`
+}
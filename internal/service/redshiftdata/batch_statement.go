@@ -0,0 +1,210 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftdata
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_redshiftdata_batch_statement", name="Batch Statement")
+func resourceBatchStatement() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceBatchStatementCreate,
+		ReadWithoutTimeout:   resourceBatchStatementRead,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrClusterIdentifier: {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			names.AttrDatabase: {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"db_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sqls": {
+				Type:     schema.TypeList,
+				Required: true,
+				ForceNew: true,
+				MinItems: 1,
+				MaxItems: 40,
+				Elem:     &schema.Schema{Type: schema.TypeString},
+			},
+			"statement_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+			"sub_statement": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"has_result_set": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						names.AttrID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"query_string": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"result_rows": {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"with_event": {
+				Type:     schema.TypeBool,
+				Optional: true,
+				ForceNew: true,
+			},
+			"workgroup_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+			},
+		},
+	}
+}
+
+func resourceBatchStatementCreate(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftDataClient(ctx)
+
+	input := &redshiftdata.BatchExecuteStatementInput{
+		Database:  aws.String(d.Get(names.AttrDatabase).(string)),
+		Sqls:      flex.ExpandStringValueList(d.Get("sqls").([]any)),
+		WithEvent: aws.Bool(d.Get("with_event").(bool)),
+	}
+
+	if v, ok := d.GetOk(names.AttrClusterIdentifier); ok {
+		input.ClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_user"); ok {
+		input.DbUser = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.SecretArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("statement_name"); ok {
+		input.StatementName = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workgroup_name"); ok {
+		input.WorkgroupName = aws.String(v.(string))
+	}
+
+	output, err := conn.BatchExecuteStatement(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "executing Redshift Data Batch Statement: %s", err)
+	}
+
+	d.SetId(aws.ToString(output.Id))
+
+	if _, err := waitStatementFinished(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Data Batch Statement (%s) finish: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceBatchStatementRead(ctx, d, meta)...)
+}
+
+func resourceBatchStatementRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftDataClient(ctx)
+
+	sub, err := FindStatementByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] Redshift Data Batch Statement (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading Redshift Data Batch Statement (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrClusterIdentifier, sub.ClusterIdentifier)
+	d.Set(names.AttrDatabase, d.Get(names.AttrDatabase).(string))
+	d.Set("db_user", d.Get("db_user").(string))
+	d.Set("secret_arn", sub.SecretArn)
+	// Expired statements only return their ID, so keep the configured SQL.
+	if len(sub.SubStatements) > 0 {
+		sqls := make([]string, 0, len(sub.SubStatements))
+		for _, v := range sub.SubStatements {
+			sqls = append(sqls, aws.ToString(v.QueryString))
+		}
+		d.Set("sqls", sqls)
+	}
+	if err := d.Set("sub_statement", flattenSubStatements(sub.SubStatements)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting sub_statement: %s", err)
+	}
+	d.Set("workgroup_name", sub.WorkgroupName)
+
+	return diags
+}
+
+func flattenSubStatements(apiObjects []types.SubStatementData) []any {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []any
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"has_result_set": aws.ToBool(apiObject.HasResultSet),
+			names.AttrID:     aws.ToString(apiObject.Id),
+			"query_string":   aws.ToString(apiObject.QueryString),
+			"result_rows":    apiObject.ResultRows,
+		})
+	}
+
+	return tfList
+}
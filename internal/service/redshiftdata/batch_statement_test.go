@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftdata_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccRedshiftDataBatchStatement_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v redshiftdata.DescribeStatementOutput
+	resourceName := "aws_redshiftdata_batch_statement.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             acctest.CheckDestroyNoop,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBatchStatementConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckStatementExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrClusterIdentifier, ""),
+					resource.TestCheckResourceAttr(resourceName, "sqls.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "sqls.0", "CREATE GROUP group_name;"),
+					resource.TestCheckResourceAttr(resourceName, "sqls.1", "GRANT USAGE ON SCHEMA public TO GROUP group_name;"),
+					resource.TestCheckResourceAttr(resourceName, "sub_statement.#", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "sub_statement.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "sub_statement.0.query_string", "CREATE GROUP group_name;"),
+					resource.TestCheckResourceAttrPair(resourceName, "workgroup_name", "aws_redshiftserverless_workgroup.test", "workgroup_name"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{names.AttrDatabase, "db_user"},
+			},
+		},
+	})
+}
+
+func testAccBatchStatementConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+resource "aws_redshiftdata_batch_statement" "test" {
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  sqls = [
+    "CREATE GROUP group_name;",
+    "GRANT USAGE ON SCHEMA public TO GROUP group_name;",
+  ]
+}
+`, rName)
+}
@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftdata
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata"
+	"github.com/aws/aws-sdk-go-v2/service/redshiftdata/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_redshiftdata_query", name="Query")
+func dataSourceQuery() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceQueryRead,
+
+		Timeouts: &schema.ResourceTimeout{
+			Read: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrClusterIdentifier: {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			"column_metadata": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"label": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"nullable": {
+							Type:     schema.TypeBool,
+							Computed: true,
+						},
+						"type_name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			names.AttrDatabase: {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"db_user": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+			names.AttrParameters: {
+				Type:     schema.TypeList,
+				Optional: true,
+				MinItems: 1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrName: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+						names.AttrValue: {
+							Type:     schema.TypeString,
+							Required: true,
+						},
+					},
+				},
+			},
+			"records": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeMap,
+					Elem: &schema.Schema{Type: schema.TypeString},
+				},
+			},
+			"secret_arn": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: verify.ValidARN,
+			},
+			"sql": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"total_num_rows": {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"workgroup_name": {
+				Type:     schema.TypeString,
+				Optional: true,
+			},
+		},
+	}
+}
+
+func dataSourceQueryRead(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).RedshiftDataClient(ctx)
+
+	sql := d.Get("sql").(string)
+	if err := validateReadOnlyStatement(sql); err != nil {
+		return sdkdiag.AppendErrorf(diags, "executing Redshift Data Query: %s", err)
+	}
+
+	input := &redshiftdata.ExecuteStatementInput{
+		Database: aws.String(d.Get(names.AttrDatabase).(string)),
+		Sql:      aws.String(sql),
+	}
+
+	if v, ok := d.GetOk(names.AttrClusterIdentifier); ok {
+		input.ClusterIdentifier = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("db_user"); ok {
+		input.DbUser = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk(names.AttrParameters); ok && len(v.([]any)) > 0 {
+		input.Parameters = expandParameters(v.([]any))
+	}
+
+	if v, ok := d.GetOk("secret_arn"); ok {
+		input.SecretArn = aws.String(v.(string))
+	}
+
+	if v, ok := d.GetOk("workgroup_name"); ok {
+		input.WorkgroupName = aws.String(v.(string))
+	}
+
+	output, err := conn.ExecuteStatement(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "executing Redshift Data Query: %s", err)
+	}
+
+	id := aws.ToString(output.Id)
+
+	statement, err := waitStatementFinished(ctx, conn, id, d.Timeout(schema.TimeoutRead))
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Redshift Data Query (%s) finish: %s", id, err)
+	}
+
+	var columns []types.ColumnMetadata
+	var records [][]types.Field
+	var totalNumRows int64
+
+	if aws.ToBool(statement.HasResultSet) {
+		columns, records, totalNumRows, err = findStatementResultByID(ctx, conn, id)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "reading Redshift Data Query (%s) result: %s", id, err)
+		}
+	}
+
+	d.SetId(id)
+	if err := d.Set("column_metadata", flattenColumnMetadata(columns)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting column_metadata: %s", err)
+	}
+	if err := d.Set("records", flattenRecords(columns, records)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting records: %s", err)
+	}
+	d.Set("total_num_rows", totalNumRows)
+
+	return diags
+}
+
+// readOnlyStatementDisallowedKeywords are the keywords which may not appear in a
+// read-only statement, e.g. a data-modifying WITH query or SELECT INTO.
+var readOnlyStatementDisallowedKeywords = []string{
+	"ALTER",
+	"CALL",
+	"COPY",
+	"CREATE",
+	"DELETE",
+	"DROP",
+	"GRANT",
+	"INSERT",
+	"INTO",
+	"MERGE",
+	"REVOKE",
+	"TRUNCATE",
+	"UNLOAD",
+	"UPDATE",
+	"VACUUM",
+}
+
+// validateReadOnlyStatement returns an error if sql is not a single SELECT or
+// WITH ... SELECT statement. Comments, string literals and quoted identifiers
+// are ignored.
+func validateReadOnlyStatement(sql string) error {
+	keywords, statements := sqlKeywords(sql)
+
+	if statements > 1 {
+		return fmt.Errorf("only a single SQL statement is allowed, got %d", statements)
+	}
+
+	if len(keywords) == 0 || (keywords[0] != "SELECT" && keywords[0] != "WITH") {
+		return fmt.Errorf("only read-only SELECT or WITH statements are allowed")
+	}
+
+	for _, keyword := range keywords {
+		if slices.Contains(readOnlyStatementDisallowedKeywords, keyword) {
+			return fmt.Errorf("only read-only statements are allowed, found %s", keyword)
+		}
+	}
+
+	return nil
+}
+
+// sqlKeywords returns the upper-cased bare words in sql, and the number of
+// non-empty statements it contains.
+func sqlKeywords(sql string) ([]string, int) {
+	var keywords []string
+	var statements int
+	var inStatement bool
+	runes := []rune(sql)
+
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		switch {
+		case r == '-' && i+1 < len(runes) && runes[i+1] == '-':
+			for i < len(runes) && runes[i] != '\n' {
+				i++
+			}
+			continue
+		case r == '/' && i+1 < len(runes) && runes[i+1] == '*':
+			i += 2
+			for i < len(runes) && (runes[i] != '*' || i+1 >= len(runes) || runes[i+1] != '/') {
+				i++
+			}
+			i++
+			continue
+		case r == ';':
+			inStatement = false
+			continue
+		case unicode.IsSpace(r):
+			continue
+		}
+
+		if !inStatement {
+			inStatement = true
+			statements++
+		}
+
+		switch {
+		case r == '\'' || r == '"':
+			i++
+			for i < len(runes) && runes[i] != r {
+				i++
+			}
+		case unicode.IsLetter(r) || r == '_':
+			j := i
+			for j < len(runes) && (unicode.IsLetter(runes[j]) || unicode.IsDigit(runes[j]) || runes[j] == '_' || runes[j] == '$') {
+				j++
+			}
+			keywords = append(keywords, strings.ToUpper(string(runes[i:j])))
+			i = j - 1
+		}
+	}
+
+	return keywords, statements
+}
+
+func findStatementResultByID(ctx context.Context, conn *redshiftdata.Client, id string) ([]types.ColumnMetadata, [][]types.Field, int64, error) {
+	input := &redshiftdata.GetStatementResultInput{
+		Id: aws.String(id),
+	}
+	var columns []types.ColumnMetadata
+	var records [][]types.Field
+	var totalNumRows int64
+
+	pages := redshiftdata.NewGetStatementResultPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		if columns == nil {
+			columns = page.ColumnMetadata
+		}
+		records = append(records, page.Records...)
+		totalNumRows = page.TotalNumRows
+	}
+
+	return columns, records, totalNumRows, nil
+}
+
+func flattenColumnMetadata(apiObjects []types.ColumnMetadata) []any {
+	tfList := make([]any, 0, len(apiObjects))
+
+	for _, apiObject := range apiObjects {
+		tfList = append(tfList, map[string]any{
+			"label":        aws.ToString(apiObject.Label),
+			names.AttrName: aws.ToString(apiObject.Name),
+			"nullable":     apiObject.Nullable != 0,
+			"type_name":    aws.ToString(apiObject.TypeName),
+		})
+	}
+
+	return tfList
+}
+
+// flattenRecords returns each record as a map of column name to string value.
+// NULL values are omitted from the map.
+func flattenRecords(columns []types.ColumnMetadata, records [][]types.Field) []any {
+	tfList := make([]any, 0, len(records))
+
+	for _, record := range records {
+		tfMap := make(map[string]any, len(record))
+
+		for i, field := range record {
+			if i >= len(columns) {
+				break
+			}
+
+			if v, ok := flattenField(field); ok {
+				tfMap[aws.ToString(columns[i].Name)] = v
+			}
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
+
+func flattenField(apiObject types.Field) (string, bool) {
+	switch v := apiObject.(type) {
+	case *types.FieldMemberBlobValue:
+		return base64.StdEncoding.EncodeToString(v.Value), true
+	case *types.FieldMemberBooleanValue:
+		return strconv.FormatBool(v.Value), true
+	case *types.FieldMemberDoubleValue:
+		return strconv.FormatFloat(v.Value, 'f', -1, 64), true
+	case *types.FieldMemberLongValue:
+		return strconv.FormatInt(v.Value, 10), true
+	case *types.FieldMemberStringValue:
+		return v.Value, true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package redshiftdata_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	tfredshiftdata "github.com/hashicorp/terraform-provider-aws/internal/service/redshiftdata"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestValidateReadOnlyStatement(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		sql         string
+		expectError bool
+	}{
+		"select": {
+			sql: "SELECT 1;",
+		},
+		"lower case with leading comments": {
+			sql: "-- comment\n/* block */ select id from t",
+		},
+		"with": {
+			sql: "WITH a AS (SELECT 1 AS x) SELECT x FROM a",
+		},
+		"parenthesized": {
+			sql: "(SELECT 1) UNION (SELECT 2)",
+		},
+		"keyword in literal": {
+			sql: "SELECT 'DELETE; DROP TABLE t' AS note",
+		},
+		"keyword in quoted identifier": {
+			sql: `SELECT "update" FROM t`,
+		},
+		"empty": {
+			sql:         "  ",
+			expectError: true,
+		},
+		"insert": {
+			sql:         "INSERT INTO t VALUES (1)",
+			expectError: true,
+		},
+		"select into": {
+			sql:         "SELECT * INTO t2 FROM t",
+			expectError: true,
+		},
+		"with delete": {
+			sql:         "WITH a AS (SELECT 1) DELETE FROM t",
+			expectError: true,
+		},
+		"multiple statements": {
+			sql:         "SELECT 1; DROP TABLE t",
+			expectError: true,
+		},
+		"commented out keyword": {
+			sql:         "/* SELECT */ UPDATE t SET x = 1",
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			err := tfredshiftdata.ValidateReadOnlyStatement(testCase.sql)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Errorf("ValidateReadOnlyStatement(%q) error = %v, expectError %t", testCase.sql, err, want)
+			}
+		})
+	}
+}
+
+func TestAccRedshiftDataQueryDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_redshiftdata_query.test"
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.RedshiftDataServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccQueryDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "column_metadata.#", "3"),
+					resource.TestCheckResourceAttr(dataSourceName, "column_metadata.0.name", names.AttrID),
+					resource.TestCheckResourceAttr(dataSourceName, "column_metadata.1.name", names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, "column_metadata.2.name", "note"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.%", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.id", "42"),
+					resource.TestCheckResourceAttr(dataSourceName, "records.0.name", rName),
+					resource.TestCheckResourceAttr(dataSourceName, "total_num_rows", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccQueryDataSourceConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_redshiftserverless_namespace" "test" {
+  namespace_name = %[1]q
+}
+
+resource "aws_redshiftserverless_workgroup" "test" {
+  namespace_name = aws_redshiftserverless_namespace.test.namespace_name
+  workgroup_name = %[1]q
+}
+
+data "aws_redshiftdata_query" "test" {
+  workgroup_name = aws_redshiftserverless_workgroup.test.workgroup_name
+  database       = "dev"
+  sql            = "SELECT CAST(:id AS BIGINT) AS id, CAST(:name AS VARCHAR) AS name, CAST(NULL AS VARCHAR) AS note;"
+
+  parameters {
+    name  = "id"
+    value = "42"
+  }
+
+  parameters {
+    name  = "name"
+    value = %[1]q
+  }
+}
+`, rName)
+}
@@ -0,0 +1,736 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_resiliencehub_app", name="App")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/resiliencehub/types;awstypes;awstypes.App")
+// @Testing(importStateIdAttribute="arn")
+// @Testing(tagsTest=false)
+func newAppResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &appResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	// The draft version is the only mutable version of an application.
+	appVersionDraft = "draft"
+)
+
+type appResource struct {
+	framework.ResourceWithModel[appResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *appResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_template_body": schema.StringAttribute{
+				Description: "The JSON application structure of the draft application version.",
+				CustomType:  jsontypes.NormalizedType{},
+				Optional:    true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"assessment_schedule": schema.StringAttribute{
+				Description: "Assessment execution schedule.",
+				CustomType:  fwtypes.StringEnumType[awstypes.AppAssessmentScheduleType](),
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compliance_status": schema.StringAttribute{
+				Description: "Current status of compliance for the resiliency policy.",
+				CustomType:  fwtypes.StringEnumType[awstypes.AppComplianceStatusType](),
+				Computed:    true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Description: "The optional description for an application.",
+				Optional:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(500),
+				},
+			},
+			"drift_status": schema.StringAttribute{
+				Description: "Indicates if compliance drifts (deviations) were detected while running an assessment for the application.",
+				CustomType:  fwtypes.StringEnumType[awstypes.AppDriftStatusType](),
+				Computed:    true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Description: "Name of the application.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(2, 60),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[A-Za-z0-9][A-Za-z0-9_-]+$`), "Must start with an alphanumeric character and contain alphanumeric characters, underscores, or hyphens"),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resiliency_policy_arn": schema.StringAttribute{
+				Description: "ARN of the resiliency policy.",
+				CustomType:  fwtypes.ARNType,
+				Optional:    true,
+			},
+			"resiliency_score": schema.Float64Attribute{
+				Description: "Current resiliency score for the application.",
+				Computed:    true,
+			},
+			"source_arns": schema.SetAttribute{
+				Description: "ARNs of the CloudFormation stacks, AppRegistry applications and resource groups whose resources are imported into the draft application version.",
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrStatus: schema.StringAttribute{
+				Description: "Status of the application.",
+				CustomType:  fwtypes.StringEnumType[awstypes.AppStatusType](),
+				Computed:    true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"eks_source": schema.SetNestedBlock{
+				Description: "Amazon EKS clusters and namespaces whose resources are imported into the draft application version.",
+				CustomType:  fwtypes.NewSetNestedObjectTypeOf[eksSourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"eks_cluster_arn": schema.StringAttribute{
+							Description: "ARN of the Amazon EKS cluster.",
+							CustomType:  fwtypes.ARNType,
+							Required:    true,
+						},
+						"namespaces": schema.SetAttribute{
+							Description: "The list of namespaces located on the Amazon EKS cluster.",
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+							Validators: []validator.Set{
+								setvalidator.SizeAtLeast(1),
+							},
+						},
+					},
+				},
+			},
+			"permission_model": schema.ListNestedBlock{
+				Description: "Defines the roles and credentials that AWS Resilience Hub would use while creating the application, importing its resources, and running an assessment.",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[permissionModelModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"cross_account_role_arns": schema.SetAttribute{
+							Description: "Defines a list of role ARNs to be used in other accounts.",
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Optional:    true,
+						},
+						"invoker_role_name": schema.StringAttribute{
+							Description: "Existing AWS IAM role name in the primary AWS account that will be assumed by AWS Resilience Hub.",
+							Optional:    true,
+						},
+						names.AttrType: schema.StringAttribute{
+							Description: "Defines how AWS Resilience Hub scans your resources.",
+							CustomType:  fwtypes.StringEnumType[awstypes.PermissionModelType](),
+							Required:    true,
+						},
+					},
+				},
+			},
+			"terraform_source": schema.SetNestedBlock{
+				Description: "Terraform state files in Amazon S3 whose resources are imported into the draft application version.",
+				CustomType:  fwtypes.NewSetNestedObjectTypeOf[terraformSourceModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"s3_state_file_url": schema.StringAttribute{
+							Description: "The URL of the Terraform state file in Amazon S3.",
+							Required:    true,
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *appResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data appResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	name := data.Name.ValueString()
+	var input resiliencehub.CreateAppInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateApp(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Resilience Hub App (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.App.AppArn)
+	data.AppARN = fwflex.StringValueToFramework(ctx, arn)
+
+	if !data.AppTemplateBody.IsNull() {
+		if err := putDraftAppVersionTemplate(ctx, conn, arn, data.AppTemplateBody.ValueString()); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.AppARN) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("putting Resilience Hub App (%s) draft version template", arn), err.Error())
+
+			return
+		}
+	}
+
+	if data.hasInputSources() {
+		input := resiliencehub.ImportResourcesToDraftAppVersionInput{
+			AppArn:         aws.String(arn),
+			ImportStrategy: awstypes.ResourceImportStrategyTypeAddOnly,
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := importResourcesToDraftAppVersion(ctx, conn, &input, r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.AppARN) // Set 'arn' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("importing resources to Resilience Hub App (%s) draft version", arn), err.Error())
+
+			return
+		}
+	}
+
+	app, err := findAppByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.AppARN) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, app, &data, fwflex.WithIgnoredFieldNamesAppend("PermissionModel"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *appResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data appResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := data.AppARN.ValueString()
+	output, err := findAppByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Applications created without a permission model report the default model.
+	if v := output.PermissionModel; data.PermissionModel.IsNull() && v != nil && v.Type == awstypes.PermissionModelTypeLegacyIamUser && v.InvokerRoleName == nil && len(v.CrossAccountRoleArns) == 0 {
+		output.PermissionModel = nil
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	sources, err := findAppInputSourcesByTwoPartKey(ctx, conn, arn, appVersionDraft)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App (%s) input sources", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, flattenAppInputSources(sources), &data, fwflex.WithIgnoredFieldNamesAppend("AppArn"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *appResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old appResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := new.AppARN.ValueString()
+
+	if !new.AssessmentSchedule.Equal(old.AssessmentSchedule) ||
+		!new.Description.Equal(old.Description) ||
+		!new.PermissionModel.Equal(old.PermissionModel) ||
+		!new.PolicyARN.Equal(old.PolicyARN) {
+		var input resiliencehub.UpdateAppInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		if new.PolicyARN.IsNull() && !old.PolicyARN.IsNull() {
+			input.ClearResiliencyPolicyArn = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateApp(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Resilience Hub App (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.AppTemplateBody.Equal(old.AppTemplateBody) && !new.AppTemplateBody.IsNull() {
+		if err := putDraftAppVersionTemplate(ctx, conn, arn, new.AppTemplateBody.ValueString()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("putting Resilience Hub App (%s) draft version template", arn), err.Error())
+
+			return
+		}
+	}
+
+	if !new.EKSSources.Equal(old.EKSSources) ||
+		!new.SourceARNs.Equal(old.SourceARNs) ||
+		!new.TerraformSources.Equal(old.TerraformSources) {
+		if new.hasInputSources() {
+			input := resiliencehub.ImportResourcesToDraftAppVersionInput{
+				AppArn:         aws.String(arn),
+				ImportStrategy: awstypes.ResourceImportStrategyTypeReplaceAll,
+			}
+			response.Diagnostics.Append(fwflex.Expand(ctx, new, &input)...)
+			if response.Diagnostics.HasError() {
+				return
+			}
+
+			if err := importResourcesToDraftAppVersion(ctx, conn, &input, r.UpdateTimeout(ctx, new.Timeouts)); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("importing resources to Resilience Hub App (%s) draft version", arn), err.Error())
+
+				return
+			}
+		} else {
+			// An import must name at least one source, so remove the previous sources individually.
+			sources, err := findAppInputSourcesByTwoPartKey(ctx, conn, arn, appVersionDraft)
+
+			if err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App (%s) input sources", arn), err.Error())
+
+				return
+			}
+
+			for _, source := range sources {
+				input := resiliencehub.DeleteAppInputSourceInput{
+					AppArn:                    aws.String(arn),
+					ClientToken:               aws.String(sdkid.UniqueId()),
+					EksSourceClusterNamespace: source.EksSourceClusterNamespace,
+					TerraformSource:           source.TerraformSource,
+				}
+				if source.EksSourceClusterNamespace == nil && source.TerraformSource == nil {
+					input.SourceArn = source.SourceArn
+				}
+
+				_, err := conn.DeleteAppInputSource(ctx, &input)
+
+				if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+					continue
+				}
+
+				if err != nil {
+					response.Diagnostics.AddError(fmt.Sprintf("deleting Resilience Hub App (%s) input source", arn), err.Error())
+
+					return
+				}
+			}
+		}
+	}
+
+	app, err := findAppByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App (%s)", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, app, &new, fwflex.WithIgnoredFieldNamesAppend("PermissionModel"))...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *appResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data appResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := data.AppARN.ValueString()
+	input := resiliencehub.DeleteAppInput{
+		AppArn:      aws.String(arn),
+		ClientToken: aws.String(sdkid.UniqueId()),
+		ForceDelete: aws.Bool(true),
+	}
+	_, err := conn.DeleteApp(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resilience Hub App (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitAppDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *appResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func putDraftAppVersionTemplate(ctx context.Context, conn *resiliencehub.Client, arn, body string) error {
+	input := resiliencehub.PutDraftAppVersionTemplateInput{
+		AppArn:          aws.String(arn),
+		AppTemplateBody: aws.String(body),
+	}
+
+	_, err := conn.PutDraftAppVersionTemplate(ctx, &input)
+
+	return err
+}
+
+func importResourcesToDraftAppVersion(ctx context.Context, conn *resiliencehub.Client, input *resiliencehub.ImportResourcesToDraftAppVersionInput, timeout time.Duration) error {
+	arn := aws.ToString(input.AppArn)
+
+	_, err := conn.ImportResourcesToDraftAppVersion(ctx, input)
+
+	if err != nil {
+		return err
+	}
+
+	_, err = waitDraftAppVersionResourcesImported(ctx, conn, arn, timeout)
+
+	return err
+}
+
+func findAppByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.App, error) {
+	input := resiliencehub.DescribeAppInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeApp(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.App == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.App, nil
+}
+
+func findAppInputSourcesByTwoPartKey(ctx context.Context, conn *resiliencehub.Client, arn, appVersion string) ([]awstypes.AppInputSource, error) {
+	input := resiliencehub.ListAppInputSourcesInput{
+		AppArn:     aws.String(arn),
+		AppVersion: aws.String(appVersion),
+	}
+	var output []awstypes.AppInputSource
+
+	pages := resiliencehub.NewListAppInputSourcesPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.AppInputSources...)
+	}
+
+	return output, nil
+}
+
+func findDraftAppVersionResourcesImportStatusByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	input := resiliencehub.DescribeDraftAppVersionResourcesImportStatusInput{
+		AppArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeDraftAppVersionResourcesImportStatus(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusApp(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findAppByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func statusDraftAppVersionResourcesImport(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findDraftAppVersionResourcesImportStatusByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAppDeleted(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.App, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AppStatusTypeActive, awstypes.AppStatusTypeDeleting),
+		Target:  []string{},
+		Refresh: statusApp(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.App); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitDraftAppVersionResourcesImported(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceImportStatusTypePending, awstypes.ResourceImportStatusTypeInProgress),
+		Target:  enum.Slice(awstypes.ResourceImportStatusTypeSuccess),
+		Refresh: statusDraftAppVersionResourcesImport(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.DescribeDraftAppVersionResourcesImportStatusOutput); ok {
+		if output.Status == awstypes.ResourceImportStatusTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// flattenAppInputSources groups the draft version's input sources by kind, in the shape accepted by ImportResourcesToDraftAppVersion.
+func flattenAppInputSources(apiObjects []awstypes.AppInputSource) *resiliencehub.ImportResourcesToDraftAppVersionInput {
+	output := &resiliencehub.ImportResourcesToDraftAppVersionInput{}
+	eksNamespaces := make(map[string][]string)
+	var eksClusterARNs []string
+
+	for _, apiObject := range apiObjects {
+		switch apiObject.ImportType {
+		case awstypes.ResourceMappingTypeEks:
+			if v := apiObject.EksSourceClusterNamespace; v != nil {
+				clusterARN := aws.ToString(v.EksClusterArn)
+				if _, ok := eksNamespaces[clusterARN]; !ok {
+					eksClusterARNs = append(eksClusterARNs, clusterARN)
+				}
+				eksNamespaces[clusterARN] = append(eksNamespaces[clusterARN], aws.ToString(v.Namespace))
+			}
+		case awstypes.ResourceMappingTypeTerraform:
+			if v := apiObject.TerraformSource; v != nil {
+				output.TerraformSources = append(output.TerraformSources, *v)
+			}
+		default:
+			if v := apiObject.SourceArn; v != nil {
+				output.SourceArns = append(output.SourceArns, aws.ToString(v))
+			}
+		}
+	}
+
+	for _, clusterARN := range eksClusterARNs {
+		output.EksSources = append(output.EksSources, awstypes.EksSource{
+			EksClusterArn: aws.String(clusterARN),
+			Namespaces:    eksNamespaces[clusterARN],
+		})
+	}
+
+	return output
+}
+
+type appResourceModel struct {
+	framework.WithRegionModel
+	AppARN             types.String                                           `tfsdk:"arn"`
+	AppTemplateBody    jsontypes.Normalized                                   `tfsdk:"app_template_body"`
+	AssessmentSchedule fwtypes.StringEnum[awstypes.AppAssessmentScheduleType] `tfsdk:"assessment_schedule"`
+	ComplianceStatus   fwtypes.StringEnum[awstypes.AppComplianceStatusType]   `tfsdk:"compliance_status"`
+	Description        types.String                                           `tfsdk:"description"`
+	DriftStatus        fwtypes.StringEnum[awstypes.AppDriftStatusType]        `tfsdk:"drift_status"`
+	EKSSources         fwtypes.SetNestedObjectValueOf[eksSourceModel]         `tfsdk:"eks_source"`
+	Name               types.String                                           `tfsdk:"name"`
+	PermissionModel    fwtypes.ListNestedObjectValueOf[permissionModelModel]  `tfsdk:"permission_model"`
+	PolicyARN          fwtypes.ARN                                            `tfsdk:"resiliency_policy_arn"`
+	ResiliencyScore    types.Float64                                          `tfsdk:"resiliency_score"`
+	SourceARNs         fwtypes.SetOfString                                    `tfsdk:"source_arns"`
+	Status             fwtypes.StringEnum[awstypes.AppStatusType]             `tfsdk:"status"`
+	Tags               tftags.Map                                             `tfsdk:"tags"`
+	TagsAll            tftags.Map                                             `tfsdk:"tags_all"`
+	TerraformSources   fwtypes.SetNestedObjectValueOf[terraformSourceModel]   `tfsdk:"terraform_source"`
+	Timeouts           timeouts.Value                                         `tfsdk:"timeouts"`
+}
+
+func (data *appResourceModel) hasInputSources() bool {
+	return len(data.EKSSources.Elements()) > 0 || len(data.SourceARNs.Elements()) > 0 || len(data.TerraformSources.Elements()) > 0
+}
+
+type eksSourceModel struct {
+	EKSClusterARN fwtypes.ARN         `tfsdk:"eks_cluster_arn"`
+	Namespaces    fwtypes.SetOfString `tfsdk:"namespaces"`
+}
+
+type permissionModelModel struct {
+	CrossAccountRoleARNs fwtypes.SetOfString                              `tfsdk:"cross_account_role_arns"`
+	InvokerRoleName      types.String                                     `tfsdk:"invoker_role_name"`
+	Type                 fwtypes.StringEnum[awstypes.PermissionModelType] `tfsdk:"type"`
+}
+
+type terraformSourceModel struct {
+	S3StateFileURL types.String `tfsdk:"s3_state_file_url"`
+}
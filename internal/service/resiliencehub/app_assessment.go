@@ -0,0 +1,572 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_resiliencehub_app_assessment", name="App Assessment")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/resiliencehub/types;awstypes;awstypes.AppAssessment")
+// @Testing(importStateIdAttribute="arn")
+// @Testing(tagsTest=false)
+func newAppAssessmentResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &appAssessmentResource{}
+
+	r.SetDefaultCreateTimeout(60 * time.Minute)
+	r.SetDefaultDeleteTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type appAssessmentResource struct {
+	framework.ResourceWithModel[appAssessmentResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *appAssessmentResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	disruptionComplianceAttributes := map[string]schema.Attribute{
+		"achievable_rpo": schema.StringAttribute{
+			Description: "The RPO that the application can achieve, as a Go duration.",
+			CustomType:  timetypes.GoDurationType{},
+			Computed:    true,
+		},
+		"achievable_rto": schema.StringAttribute{
+			Description: "The RTO that the application can achieve, as a Go duration.",
+			CustomType:  timetypes.GoDurationType{},
+			Computed:    true,
+		},
+		"compliance_status": schema.StringAttribute{
+			Description: "The current status of compliance for the resiliency policy.",
+			CustomType:  fwtypes.StringEnumType[awstypes.ComplianceStatus](),
+			Computed:    true,
+		},
+		"current_rpo": schema.StringAttribute{
+			Description: "The current RPO, as a Go duration.",
+			CustomType:  timetypes.GoDurationType{},
+			Computed:    true,
+		},
+		"current_rto": schema.StringAttribute{
+			Description: "The current RTO, as a Go duration.",
+			CustomType:  timetypes.GoDurationType{},
+			Computed:    true,
+		},
+		"disruption_type": schema.StringAttribute{
+			Description: "The type of disruption.",
+			CustomType:  fwtypes.StringEnumType[awstypes.DisruptionType](),
+			Computed:    true,
+		},
+		names.AttrMessage: schema.StringAttribute{
+			Description: "The disruption compliance message.",
+			Computed:    true,
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_arn": schema.StringAttribute{
+				Description: "ARN of the Resilience Hub application.",
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_version": schema.StringAttribute{
+				Description: "The version of the application to assess.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"assessment_status": schema.StringAttribute{
+				Description: "The current status of the assessment.",
+				CustomType:  fwtypes.StringEnumType[awstypes.AssessmentStatus](),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"compliance": schema.ListNestedAttribute{
+				Description: "The application compliance against the resiliency policy, by disruption type.",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[disruptionComplianceModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: disruptionComplianceAttributes,
+				},
+			},
+			"compliance_status": schema.StringAttribute{
+				Description: "The current status of compliance for the resiliency policy.",
+				CustomType:  fwtypes.StringEnumType[awstypes.ComplianceStatus](),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"component_recommendation": schema.ListNestedAttribute{
+				Description: "The recommendations for the application's components.",
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[componentRecommendationModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"app_component_name": schema.StringAttribute{
+							Description: "The name of the Application Component.",
+							Computed:    true,
+						},
+						"config_recommendation": schema.ListNestedAttribute{
+							Description: "The list of recommendations.",
+							CustomType:  fwtypes.NewListNestedObjectTypeOf[configRecommendationModel](ctx),
+							Computed:    true,
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDescription: schema.StringAttribute{
+										Description: "The optional description for an app.",
+										Computed:    true,
+									},
+									"ha_architecture": schema.StringAttribute{
+										Description: "The architecture type.",
+										CustomType:  fwtypes.StringEnumType[awstypes.HaArchitecture](),
+										Computed:    true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Description: "The name of the recommendation configuration.",
+										Computed:    true,
+									},
+									"optimization_type": schema.StringAttribute{
+										Description: "The type of optimization.",
+										CustomType:  fwtypes.StringEnumType[awstypes.ConfigRecommendationOptimizationType](),
+										Computed:    true,
+									},
+									"reference_id": schema.StringAttribute{
+										Description: "The reference identifier for the recommendation configuration.",
+										Computed:    true,
+									},
+									"suggested_changes": schema.ListAttribute{
+										Description: "List of the suggested configuration changes.",
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Computed:    true,
+									},
+								},
+							},
+						},
+						"recommendation_status": schema.StringAttribute{
+							Description: "The recommendation status.",
+							CustomType:  fwtypes.StringEnumType[awstypes.RecommendationComplianceStatus](),
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"drift_status": schema.StringAttribute{
+				Description: "Indicates if compliance drifts (deviations) were detected while running an assessment for the application.",
+				CustomType:  fwtypes.StringEnumType[awstypes.DriftStatus](),
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"end_time": schema.StringAttribute{
+				Description: "End time for the action.",
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Description: "Name of the assessment.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"resiliency_score": schema.Float64Attribute{
+				Description: "The overall resiliency score for the application.",
+				Computed:    true,
+			},
+			names.AttrStartTime: schema.StringAttribute{
+				Description: "Start time for the action.",
+				CustomType:  timetypes.RFC3339Type{},
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *appAssessmentResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data appAssessmentResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	name := data.AssessmentName.ValueString()
+	var input resiliencehub.StartAppAssessmentInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.StartAppAssessment(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("starting Resilience Hub App Assessment (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.Assessment.AssessmentArn)
+	data.AssessmentARN = fwflex.StringValueToFramework(ctx, arn)
+
+	assessment, err := waitAppAssessmentSucceeded(ctx, conn, arn, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.AssessmentARN) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App Assessment (%s) create", arn), err.Error())
+
+		return
+	}
+
+	recommendations, err := findAppComponentRecommendationsByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrARN), data.AssessmentARN) // Set 'arn' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App Assessment (%s) component recommendations", arn), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, assessment, recommendations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *appAssessmentResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data appAssessmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := data.AssessmentARN.ValueString()
+	output, err := findAppAssessmentByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App Assessment (%s)", arn), err.Error())
+
+		return
+	}
+
+	var recommendations []awstypes.ComponentRecommendation
+	if output.AssessmentStatus == awstypes.AssessmentStatusSuccess {
+		recommendations, err = findAppComponentRecommendationsByARN(ctx, conn, arn)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App Assessment (%s) component recommendations", arn), err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output, recommendations)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *appAssessmentResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data appAssessmentResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	arn := data.AssessmentARN.ValueString()
+	input := resiliencehub.DeleteAppAssessmentInput{
+		AssessmentArn: aws.String(arn),
+		ClientToken:   aws.String(sdkid.UniqueId()),
+	}
+	_, err := conn.DeleteAppAssessment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Resilience Hub App Assessment (%s)", arn), err.Error())
+
+		return
+	}
+
+	if _, err := waitAppAssessmentDeleted(ctx, conn, arn, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App Assessment (%s) delete", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *appAssessmentResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findAppAssessmentByARN(ctx context.Context, conn *resiliencehub.Client, arn string) (*awstypes.AppAssessment, error) {
+	input := resiliencehub.DescribeAppAssessmentInput{
+		AssessmentArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeAppAssessment(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Assessment == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Assessment, nil
+}
+
+func findAppComponentRecommendationsByARN(ctx context.Context, conn *resiliencehub.Client, arn string) ([]awstypes.ComponentRecommendation, error) {
+	input := resiliencehub.ListAppComponentRecommendationsInput{
+		AssessmentArn: aws.String(arn),
+	}
+	var output []awstypes.ComponentRecommendation
+
+	pages := resiliencehub.NewListAppComponentRecommendationsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ComponentRecommendations...)
+	}
+
+	return output, nil
+}
+
+func statusAppAssessment(ctx context.Context, conn *resiliencehub.Client, arn string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findAppAssessmentByARN(ctx, conn, arn)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.AssessmentStatus), nil
+	}
+}
+
+func waitAppAssessmentSucceeded(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.AppAssessment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AssessmentStatusPending, awstypes.AssessmentStatusInprogress),
+		Target:  enum.Slice(awstypes.AssessmentStatusSuccess),
+		Refresh: statusAppAssessment(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppAssessment); ok {
+		if output.AssessmentStatus == awstypes.AssessmentStatusFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.Message)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitAppAssessmentDeleted(ctx context.Context, conn *resiliencehub.Client, arn string, timeout time.Duration) (*awstypes.AppAssessment, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.AssessmentStatusPending, awstypes.AssessmentStatusInprogress, awstypes.AssessmentStatusSuccess, awstypes.AssessmentStatusFailed),
+		Target:  []string{},
+		Refresh: statusAppAssessment(ctx, conn, arn),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.AppAssessment); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+type appAssessmentResourceModel struct {
+	framework.WithRegionModel
+	AppARN                  fwtypes.ARN                                                   `tfsdk:"app_arn"`
+	AppVersion              types.String                                                  `tfsdk:"app_version"`
+	AssessmentARN           types.String                                                  `tfsdk:"arn"`
+	AssessmentName          types.String                                                  `tfsdk:"name"`
+	AssessmentStatus        fwtypes.StringEnum[awstypes.AssessmentStatus]                 `tfsdk:"assessment_status"`
+	Compliance              fwtypes.ListNestedObjectValueOf[disruptionComplianceModel]    `tfsdk:"compliance"`
+	ComplianceStatus        fwtypes.StringEnum[awstypes.ComplianceStatus]                 `tfsdk:"compliance_status"`
+	ComponentRecommendation fwtypes.ListNestedObjectValueOf[componentRecommendationModel] `tfsdk:"component_recommendation"`
+	DriftStatus             fwtypes.StringEnum[awstypes.DriftStatus]                      `tfsdk:"drift_status"`
+	EndTime                 timetypes.RFC3339                                             `tfsdk:"end_time"`
+	ResiliencyScore         types.Float64                                                 `tfsdk:"resiliency_score"`
+	StartTime               timetypes.RFC3339                                             `tfsdk:"start_time"`
+	Tags                    tftags.Map                                                    `tfsdk:"tags"`
+	TagsAll                 tftags.Map                                                    `tfsdk:"tags_all"`
+	Timeouts                timeouts.Value                                                `tfsdk:"timeouts"`
+}
+
+func (data *appAssessmentResourceModel) flatten(ctx context.Context, assessment *awstypes.AppAssessment, recommendations []awstypes.ComponentRecommendation) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, assessment, data, fwflex.WithIgnoredFieldNamesAppend("Compliance"), fwflex.WithIgnoredFieldNamesAppend("ResiliencyScore"))...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Compliance is returned as a map keyed by disruption type.
+	disruptionTypes := make([]string, 0, len(assessment.Compliance))
+	for k := range assessment.Compliance {
+		disruptionTypes = append(disruptionTypes, k)
+	}
+	slices.Sort(disruptionTypes)
+
+	compliance := make([]*disruptionComplianceModel, 0, len(disruptionTypes))
+	for _, k := range disruptionTypes {
+		v := assessment.Compliance[k]
+		compliance = append(compliance, &disruptionComplianceModel{
+			AchievableRPO:    timetypes.NewGoDurationValue(time.Duration(v.AchievableRpoInSecs) * time.Second),
+			AchievableRTO:    timetypes.NewGoDurationValue(time.Duration(v.AchievableRtoInSecs) * time.Second),
+			ComplianceStatus: fwtypes.StringEnumValue(v.ComplianceStatus),
+			CurrentRPO:       timetypes.NewGoDurationValue(time.Duration(v.CurrentRpoInSecs) * time.Second),
+			CurrentRTO:       timetypes.NewGoDurationValue(time.Duration(v.CurrentRtoInSecs) * time.Second),
+			DisruptionType:   fwtypes.StringEnumValue(awstypes.DisruptionType(k)),
+			Message:          fwflex.StringToFramework(ctx, v.Message),
+		})
+	}
+	data.Compliance = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, compliance)
+
+	if v := assessment.ResiliencyScore; v != nil {
+		data.ResiliencyScore = types.Float64Value(v.Score)
+	} else {
+		data.ResiliencyScore = types.Float64Null()
+	}
+
+	diags.Append(fwflex.Flatten(ctx, recommendations, &data.ComponentRecommendation)...)
+
+	return diags
+}
+
+type disruptionComplianceModel struct {
+	AchievableRPO    timetypes.GoDuration                          `tfsdk:"achievable_rpo"`
+	AchievableRTO    timetypes.GoDuration                          `tfsdk:"achievable_rto"`
+	ComplianceStatus fwtypes.StringEnum[awstypes.ComplianceStatus] `tfsdk:"compliance_status"`
+	CurrentRPO       timetypes.GoDuration                          `tfsdk:"current_rpo"`
+	CurrentRTO       timetypes.GoDuration                          `tfsdk:"current_rto"`
+	DisruptionType   fwtypes.StringEnum[awstypes.DisruptionType]   `tfsdk:"disruption_type"`
+	Message          types.String                                  `tfsdk:"message"`
+}
+
+type componentRecommendationModel struct {
+	AppComponentName      types.String                                                `tfsdk:"app_component_name"`
+	ConfigRecommendations fwtypes.ListNestedObjectValueOf[configRecommendationModel]  `tfsdk:"config_recommendation"`
+	RecommendationStatus  fwtypes.StringEnum[awstypes.RecommendationComplianceStatus] `tfsdk:"recommendation_status"`
+}
+
+type configRecommendationModel struct {
+	Description      types.String                                                      `tfsdk:"description"`
+	HAArchitecture   fwtypes.StringEnum[awstypes.HaArchitecture]                       `tfsdk:"ha_architecture"`
+	Name             types.String                                                      `tfsdk:"name"`
+	OptimizationType fwtypes.StringEnum[awstypes.ConfigRecommendationOptimizationType] `tfsdk:"optimization_type"`
+	ReferenceID      types.String                                                      `tfsdk:"reference_id"`
+	SuggestedChanges fwtypes.ListOfString                                              `tfsdk:"suggested_changes"`
+}
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resiliencehub
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resiliencehub"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/resiliencehub/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_resiliencehub_app_version", name="App Version")
+func newAppVersionResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &appVersionResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type appVersionResource struct {
+	framework.ResourceWithModel[appVersionResourceModel]
+	framework.WithNoUpdate
+	framework.WithNoOpDelete // Published application versions cannot be deleted; they are removed along with the application.
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (r *appVersionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"app_arn": schema.StringAttribute{
+				Description: "ARN of the Resilience Hub application.",
+				CustomType:  fwtypes.ARNType,
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_version": schema.StringAttribute{
+				Description: "The version of the application.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrIdentifier: schema.Int64Attribute{
+				Description: "Identifier of the application version.",
+				Computed:    true,
+			},
+			"version_name": schema.StringAttribute{
+				Description: "Name of the application version.",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(50),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+			}),
+		},
+	}
+}
+
+func (r *appVersionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data appVersionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	appARN := data.AppARN.ValueString()
+
+	// Resources in the draft version must be resolved before it can be published.
+	inputRAVR := resiliencehub.ResolveAppVersionResourcesInput{
+		AppArn:     aws.String(appARN),
+		AppVersion: aws.String(appVersionDraft),
+	}
+	outputRAVR, err := conn.ResolveAppVersionResources(ctx, &inputRAVR)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("resolving Resilience Hub App (%s) draft version resources", appARN), err.Error())
+
+		return
+	}
+
+	if _, err := waitAppVersionResourcesResolved(ctx, conn, appARN, appVersionDraft, aws.ToString(outputRAVR.ResolutionId), r.CreateTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Resilience Hub App (%s) draft version resources resolve", appARN), err.Error())
+
+		return
+	}
+
+	var input resiliencehub.PublishAppVersionInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PublishAppVersion(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("publishing Resilience Hub App (%s) version", appARN), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	id, err := data.setID()
+	if err != nil {
+		response.Diagnostics.AddError("creating Resilience Hub App Version", err.Error())
+
+		return
+	}
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *appVersionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data appVersionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().ResilienceHubClient(ctx)
+
+	output, err := findAppVersionByTwoPartKey(ctx, conn, data.AppARN.ValueString(), data.AppVersion.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Resilience Hub App Version (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findAppVersionByTwoPartKey(ctx context.Context, conn *resiliencehub.Client, appARN, appVersion string) (*awstypes.AppVersionSummary, error) {
+	input := resiliencehub.ListAppVersionsInput{
+		AppArn: aws.String(appARN),
+	}
+
+	pages := resiliencehub.NewListAppVersionsPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AppVersions {
+			if aws.ToString(v.AppVersion) == appVersion {
+				return &v, nil
+			}
+		}
+	}
+
+	return nil, &retry.NotFoundError{
+		LastRequest: input,
+	}
+}
+
+func findAppVersionResourcesResolutionStatusByThreePartKey(ctx context.Context, conn *resiliencehub.Client, appARN, appVersion, resolutionID string) (*resiliencehub.DescribeAppVersionResourcesResolutionStatusOutput, error) {
+	input := resiliencehub.DescribeAppVersionResourcesResolutionStatusInput{
+		AppArn:       aws.String(appARN),
+		AppVersion:   aws.String(appVersion),
+		ResolutionId: aws.String(resolutionID),
+	}
+
+	output, err := conn.DescribeAppVersionResourcesResolutionStatus(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusAppVersionResourcesResolution(ctx context.Context, conn *resiliencehub.Client, appARN, appVersion, resolutionID string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findAppVersionResourcesResolutionStatusByThreePartKey(ctx, conn, appARN, appVersion, resolutionID)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitAppVersionResourcesResolved(ctx context.Context, conn *resiliencehub.Client, appARN, appVersion, resolutionID string, timeout time.Duration) (*resiliencehub.DescribeAppVersionResourcesResolutionStatusOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.ResourceResolutionStatusTypePending, awstypes.ResourceResolutionStatusTypeInProgress),
+		Target:  enum.Slice(awstypes.ResourceResolutionStatusTypeSuccess),
+		Refresh: statusAppVersionResourcesResolution(ctx, conn, appARN, appVersion, resolutionID),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*resiliencehub.DescribeAppVersionResourcesResolutionStatusOutput); ok {
+		if output.Status == awstypes.ResourceResolutionStatusTypeFailed {
+			tfresource.SetLastError(err, errors.New(aws.ToString(output.ErrorMessage)))
+		}
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type appVersionResourceModel struct {
+	framework.WithRegionModel
+	AppARN      fwtypes.ARN    `tfsdk:"app_arn"`
+	AppVersion  types.String   `tfsdk:"app_version"`
+	ID          types.String   `tfsdk:"id"`
+	Identifier  types.Int64    `tfsdk:"identifier"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
+	VersionName types.String   `tfsdk:"version_name"`
+}
+
+const (
+	appVersionResourceIDPartCount = 2
+)
+
+func (data *appVersionResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), appVersionResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.AppARN = fwtypes.ARNValue(parts[0])
+	data.AppVersion = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *appVersionResourceModel) setID() (string, error) {
+	parts := []string{
+		data.AppARN.ValueString(),
+		data.AppVersion.ValueString(),
+	}
+
+	return flex.FlattenResourceId(parts, appVersionResourceIDPartCount, false)
+}
@@ -5,5 +5,12 @@ package resiliencehub
 
 // Exports for use in tests only.
 var (
+	ResourceApp              = newAppResource
+	ResourceAppAssessment    = newAppAssessmentResource
+	ResourceAppVersion       = newAppVersionResource
 	ResourceResiliencyPolicy = newResiliencyPolicyResource
+
+	FindAppAssessmentByARN     = findAppAssessmentByARN
+	FindAppByARN               = findAppByARN
+	FindAppVersionByTwoPartKey = findAppVersionByTwoPartKey
 )
@@ -24,6 +24,30 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*inttypes.S
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.ServicePackageFrameworkResource {
 	return []*inttypes.ServicePackageFrameworkResource{
+		{
+			Factory:  newAppResource,
+			TypeName: "aws_resiliencehub_app",
+			Name:     "App",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newAppAssessmentResource,
+			TypeName: "aws_resiliencehub_app_assessment",
+			Name:     "App Assessment",
+			Tags: unique.Make(inttypes.ServicePackageResourceTags{
+				IdentifierAttribute: names.AttrARN,
+			}),
+			Region: unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newAppVersionResource,
+			TypeName: "aws_resiliencehub_app_version",
+			Name:     "App Version",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newResiliencyPolicyResource,
 			TypeName: "aws_resiliencehub_resiliency_policy",
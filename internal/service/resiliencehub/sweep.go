@@ -16,7 +16,30 @@ import (
 )
 
 func RegisterSweepers() {
-	awsv2.Register("aws_resiliencehub_resiliency_policy", sweepResiliencyPolicy)
+	awsv2.Register("aws_resiliencehub_app", sweepApps)
+	awsv2.Register("aws_resiliencehub_resiliency_policy", sweepResiliencyPolicy, "aws_resiliencehub_app")
+}
+
+func sweepApps(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ResilienceHubClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := resiliencehub.NewListAppsPaginator(conn, &resiliencehub.ListAppsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.AppSummaries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAppResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.AppArn)),
+			))
+		}
+	}
+
+	return sweepResources, nil
 }
 
 func sweepResiliencyPolicy(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
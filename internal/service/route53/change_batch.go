@@ -0,0 +1,334 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// See https://docs.aws.amazon.com/Route53/latest/DeveloperGuide/DNSLimitations.html#limits-api-requests-changeresourcerecordsets.
+const (
+	changeBatchMaxResourceRecords = 1000
+	changeBatchMaxValueCharacters = 32000
+)
+
+const (
+	// changeBatchSubmitTimeout bounds each ChangeResourceRecordSets call, including retries, made for a change batch.
+	// The call is detached from the callers' contexts, so without a bound a hung call would block every later change to the hosted zone.
+	changeBatchSubmitTimeout = 5 * time.Minute
+	// changeInsyncPollTimeout bounds a shared wait for a change to synchronize.
+	// The wait is also cancelled as soon as no caller is waiting, and each caller is bounded by its own context and timeout.
+	changeInsyncPollTimeout = 24 * time.Hour
+)
+
+var (
+	changeBatchers sync.Map // changeBatcherKey -> *changeBatcher
+)
+
+type changeBatcherKey struct {
+	conn    *route53.Client
+	zoneID  string
+	comment string
+}
+
+// changeBatcher coalesces concurrent resource record set changes to a hosted zone into a single
+// ChangeResourceRecordSets call, and waits once for the resulting change to synchronize.
+//
+// A change is submitted immediately if no call for the hosted zone is in flight. Otherwise it is
+// queued and submitted, together with any other queued changes, once the in-flight call returns.
+type changeBatcher struct {
+	key changeBatcherKey
+
+	mu       sync.Mutex
+	pending  []*changeRequest
+	inFlight bool
+	closed   bool
+}
+
+// changeRequest is a set of changes that must be applied together, e.g. the DELETE and CREATE of a renamed record.
+type changeRequest struct {
+	ctx     context.Context
+	changes []awstypes.Change
+	done    chan struct{}
+	waiter  *changeInsyncWaiter
+	err     error
+}
+
+// changeInsyncWaiter shares a single wait for a change to synchronize between all the requests in a batch.
+type changeInsyncWaiter struct {
+	conn *route53.Client
+	id   string
+
+	mu      sync.Mutex
+	waiters int
+	poll    *changeInsyncPoll
+}
+
+// changeInsyncPoll is a single run of polling for a change to synchronize.
+type changeInsyncPoll struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+	output *awstypes.ChangeInfo
+	err    error
+}
+
+// changeResourceRecordSets applies the changes to the hosted zone, batched with any other concurrent changes to the same zone
+// with the same comment. The returned waiter is used to wait for the changes to synchronize.
+func changeResourceRecordSets(ctx context.Context, conn *route53.Client, zoneID, comment string, changes []awstypes.Change) (*changeInsyncWaiter, error) {
+	key := changeBatcherKey{conn: conn, zoneID: zoneID, comment: comment}
+	request := &changeRequest{
+		ctx:     ctx,
+		changes: changes,
+		done:    make(chan struct{}),
+	}
+
+	for {
+		v, _ := changeBatchers.LoadOrStore(key, &changeBatcher{key: key})
+
+		if v.(*changeBatcher).submit(request) {
+			break
+		}
+	}
+
+	// If the context is cancelled the changes may still be applied.
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-request.done:
+		return request.waiter, request.err
+	}
+}
+
+// submit queues the request. It returns false if the batcher has been removed from changeBatchers.
+func (b *changeBatcher) submit(request *changeRequest) bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return false
+	}
+
+	b.pending = append(b.pending, request)
+	if !b.inFlight {
+		b.flushLocked()
+	}
+
+	return true
+}
+
+// flushLocked submits as many pending requests as fit in a single change batch.
+func (b *changeBatcher) flushLocked() {
+	var requests []*changeRequest
+	requests, b.pending = nextChangeBatch(b.pending)
+	b.inFlight = true
+
+	go b.execute(requests)
+}
+
+// done is called when a change batch has been submitted. It submits any requests queued in the meantime,
+// or removes the idle batcher.
+func (b *changeBatcher) done() {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.inFlight = false
+
+	if len(b.pending) > 0 {
+		b.flushLocked()
+		return
+	}
+
+	b.closed = true
+	changeBatchers.CompareAndDelete(b.key, b)
+}
+
+func (b *changeBatcher) execute(requests []*changeRequest) {
+	defer b.done()
+
+	ctx := context.WithoutCancel(requests[0].ctx)
+
+	var changes []awstypes.Change
+	for _, v := range requests {
+		changes = append(changes, v.changes...)
+	}
+
+	tflog.Debug(ctx, "Submitting Route 53 change batch", map[string]any{
+		"hosted_zone_id": b.key.zoneID,
+		"changes":        len(changes),
+		"requests":       len(requests),
+	})
+
+	output, err := b.changeResourceRecordSets(ctx, changes)
+
+	// A change batch is applied atomically, so a single invalid change fails the whole batch.
+	// Unless the failure is unrelated to the changes, resubmit each request on its own so that every resource reports its own result.
+	if err != nil && len(requests) > 1 && !isChangeBatchTransientError(err) {
+		for _, v := range requests {
+			output, err := b.changeResourceRecordSets(ctx, v.changes)
+			v.complete(newChangeInsyncWaiter(b.key.conn, output), err)
+		}
+
+		return
+	}
+
+	waiter := newChangeInsyncWaiter(b.key.conn, output)
+	for _, v := range requests {
+		v.complete(waiter, err)
+	}
+}
+
+func (b *changeBatcher) changeResourceRecordSets(ctx context.Context, changes []awstypes.Change) (*route53.ChangeResourceRecordSetsOutput, error) {
+	input := route53.ChangeResourceRecordSetsInput{
+		ChangeBatch: &awstypes.ChangeBatch{
+			Changes: changes,
+			Comment: aws.String(b.key.comment),
+		},
+		HostedZoneId: aws.String(b.key.zoneID),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, changeBatchSubmitTimeout)
+	defer cancel()
+
+	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.NoSuchHostedZone](ctx, 1*time.Minute, func() (any, error) {
+		return b.key.conn.ChangeResourceRecordSets(ctx, &input)
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return outputRaw.(*route53.ChangeResourceRecordSetsOutput), nil
+}
+
+// isChangeBatchTransientError returns whether the error is caused by throttling or a timeout rather than by the changes themselves.
+func isChangeBatchTransientError(err error) bool {
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return true
+	}
+
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err).Bool()
+}
+
+func (r *changeRequest) complete(waiter *changeInsyncWaiter, err error) {
+	r.waiter, r.err = waiter, err
+	close(r.done)
+}
+
+func newChangeInsyncWaiter(conn *route53.Client, output *route53.ChangeResourceRecordSetsOutput) *changeInsyncWaiter {
+	if output == nil || output.ChangeInfo == nil {
+		return nil
+	}
+
+	return &changeInsyncWaiter{
+		conn: conn,
+		id:   aws.ToString(output.ChangeInfo.Id),
+	}
+}
+
+// wait waits for the change to synchronize, bounded by the caller's context and timeout.
+// Concurrent callers share a single poll of the change status, which is cancelled when no caller is waiting.
+func (w *changeInsyncWaiter) wait(ctx context.Context, timeout time.Duration) (*awstypes.ChangeInfo, error) {
+	if w == nil {
+		return nil, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	w.mu.Lock()
+	poll := w.poll
+	if poll == nil {
+		pollCtx, pollCancel := context.WithCancel(context.WithoutCancel(ctx))
+		poll = &changeInsyncPoll{
+			cancel: pollCancel,
+			done:   make(chan struct{}),
+		}
+		w.poll = poll
+
+		go func() {
+			defer close(poll.done)
+			poll.output, poll.err = waitChangeInsync(pollCtx, w.conn, w.id, changeInsyncPollTimeout)
+		}()
+	}
+	w.waiters++
+	w.mu.Unlock()
+
+	defer func() {
+		w.mu.Lock()
+		defer w.mu.Unlock()
+
+		w.waiters--
+
+		// Stop an unfinished poll that no one is waiting for. A later caller starts a new one.
+		if w.waiters == 0 && w.poll == poll {
+			select {
+			case <-poll.done:
+			default:
+				poll.cancel()
+				w.poll = nil
+			}
+		}
+	}()
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-poll.done:
+		return poll.output, poll.err
+	}
+}
+
+// nextChangeBatch splits off the requests that fit in the next change batch. The first request is always included.
+func nextChangeBatch(requests []*changeRequest) ([]*changeRequest, []*changeRequest) {
+	var resourceRecords, valueCharacters int
+
+	for i, v := range requests {
+		n, m := changeBatchSize(v.changes)
+
+		if i > 0 && (resourceRecords+n > changeBatchMaxResourceRecords || valueCharacters+m > changeBatchMaxValueCharacters) {
+			return requests[:i], requests[i:]
+		}
+
+		resourceRecords += n
+		valueCharacters += m
+	}
+
+	return requests, nil
+}
+
+// changeBatchSize returns the number of resource records and value characters that count towards the change batch limits.
+func changeBatchSize(changes []awstypes.Change) (int, int) {
+	var resourceRecords, valueCharacters int
+
+	for _, change := range changes {
+		n, m := 1, 0
+
+		if v := change.ResourceRecordSet; v != nil && len(v.ResourceRecords) > 0 {
+			n = len(v.ResourceRecords)
+			for _, v := range v.ResourceRecords {
+				m += len(aws.ToString(v.Value))
+			}
+		}
+
+		// UPSERT counts twice.
+		if change.Action == awstypes.ChangeActionUpsert {
+			n, m = n*2, m*2
+		}
+
+		resourceRecords += n
+		valueCharacters += m
+	}
+
+	return resourceRecords, valueCharacters
+}
@@ -0,0 +1,292 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package route53
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/route53/types"
+	"github.com/aws/smithy-go/middleware"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+func Test_changeBatchSize(t *testing.T) {
+	t.Parallel()
+
+	recordSet := &awstypes.ResourceRecordSet{
+		Name: aws.String("example.com"),
+		Type: awstypes.RRTypeA,
+		TTL:  aws.Int64(300),
+		ResourceRecords: []awstypes.ResourceRecord{
+			{
+				Value: aws.String("127.0.0.1"),
+			},
+			{
+				Value: aws.String("127.0.0.27"),
+			},
+		},
+	}
+	aliasRecordSet := &awstypes.ResourceRecordSet{
+		Name: aws.String("www.example.com"),
+		Type: awstypes.RRTypeA,
+		AliasTarget: &awstypes.AliasTarget{
+			DNSName:      aws.String("example.com"),
+			HostedZoneId: aws.String("Z123456"),
+		},
+	}
+
+	tests := []struct {
+		name                string
+		changes             []awstypes.Change
+		wantResourceRecords int
+		wantValueCharacters int
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "create",
+			changes: []awstypes.Change{
+				{
+					Action:            awstypes.ChangeActionCreate,
+					ResourceRecordSet: recordSet,
+				},
+			},
+			wantResourceRecords: 2,
+			wantValueCharacters: 19,
+		},
+		{
+			name: "upsert",
+			changes: []awstypes.Change{
+				{
+					Action:            awstypes.ChangeActionUpsert,
+					ResourceRecordSet: recordSet,
+				},
+			},
+			wantResourceRecords: 4,
+			wantValueCharacters: 38,
+		},
+		{
+			name: "alias",
+			changes: []awstypes.Change{
+				{
+					Action:            awstypes.ChangeActionDelete,
+					ResourceRecordSet: aliasRecordSet,
+				},
+			},
+			wantResourceRecords: 1,
+		},
+		{
+			name: "multiple",
+			changes: []awstypes.Change{
+				{
+					Action:            awstypes.ChangeActionDelete,
+					ResourceRecordSet: recordSet,
+				},
+				{
+					Action:            awstypes.ChangeActionCreate,
+					ResourceRecordSet: aliasRecordSet,
+				},
+			},
+			wantResourceRecords: 3,
+			wantValueCharacters: 19,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotResourceRecords, gotValueCharacters := changeBatchSize(tt.changes)
+
+			if got, want := gotResourceRecords, tt.wantResourceRecords; got != want {
+				t.Errorf("resource records = %d, want %d", got, want)
+			}
+			if got, want := gotValueCharacters, tt.wantValueCharacters; got != want {
+				t.Errorf("value characters = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func Test_nextChangeBatch(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(n int) *changeRequest {
+		recordSet := &awstypes.ResourceRecordSet{
+			Name: aws.String("example.com"),
+			Type: awstypes.RRTypeA,
+		}
+		for range n {
+			recordSet.ResourceRecords = append(recordSet.ResourceRecords, awstypes.ResourceRecord{Value: aws.String("127.0.0.1")})
+		}
+
+		return &changeRequest{
+			changes: []awstypes.Change{
+				{
+					Action:            awstypes.ChangeActionCreate,
+					ResourceRecordSet: recordSet,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		requests  []*changeRequest
+		wantBatch int
+		wantRest  int
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name:      "single",
+			requests:  []*changeRequest{newRequest(1)},
+			wantBatch: 1,
+		},
+		{
+			name:      "fits",
+			requests:  []*changeRequest{newRequest(400), newRequest(400), newRequest(200)},
+			wantBatch: 3,
+		},
+		{
+			name:      "overflow",
+			requests:  []*changeRequest{newRequest(400), newRequest(400), newRequest(201), newRequest(1)},
+			wantBatch: 2,
+			wantRest:  2,
+		},
+		{
+			name:      "first too large",
+			requests:  []*changeRequest{newRequest(1001), newRequest(1)},
+			wantBatch: 1,
+			wantRest:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			gotBatch, gotRest := nextChangeBatch(tt.requests)
+
+			if got, want := len(gotBatch), tt.wantBatch; got != want {
+				t.Errorf("batch = %d, want %d", got, want)
+			}
+			if got, want := len(gotRest), tt.wantRest; got != want {
+				t.Errorf("rest = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func Test_changeBatcherExecute(t *testing.T) {
+	t.Parallel()
+
+	newRequest := func(name string) *changeRequest {
+		return &changeRequest{
+			ctx: context.Background(),
+			changes: []awstypes.Change{
+				{
+					Action: awstypes.ChangeActionCreate,
+					ResourceRecordSet: &awstypes.ResourceRecordSet{
+						Name: aws.String(name),
+						Type: awstypes.RRTypeA,
+					},
+				},
+			},
+			done: make(chan struct{}),
+		}
+	}
+
+	tests := []struct {
+		name      string
+		err       error
+		isErr     func(error) bool
+		wantCalls int32
+		wantErrs  []bool
+	}{
+		{
+			name:      "invalid input",
+			isErr:     errs.IsA[*awstypes.InvalidInput],
+			err:       &awstypes.InvalidInput{Message: aws.String("invalid record")},
+			wantCalls: 4,
+			wantErrs:  []bool{false, true, false},
+		},
+		{
+			name:      "invalid change batch",
+			isErr:     errs.IsA[*awstypes.InvalidChangeBatch],
+			err:       &awstypes.InvalidChangeBatch{Message: aws.String("invalid record")},
+			wantCalls: 4,
+			wantErrs:  []bool{false, true, false},
+		},
+		{
+			name:      "throttled",
+			isErr:     errs.IsA[*awstypes.ThrottlingException],
+			err:       &awstypes.ThrottlingException{Message: aws.String("rate exceeded")},
+			wantCalls: 1,
+			wantErrs:  []bool{true, true, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Any batch that contains the "invalid." record fails with the test case's error.
+			var calls atomic.Int32
+			conn := route53.New(route53.Options{
+				Region: "us-east-1", //lintignore:AWSAT003
+				APIOptions: []func(*middleware.Stack) error{
+					func(stack *middleware.Stack) error {
+						return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("mock", func(ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler) (middleware.InitializeOutput, middleware.Metadata, error) {
+							calls.Add(1)
+
+							for _, v := range in.Parameters.(*route53.ChangeResourceRecordSetsInput).ChangeBatch.Changes {
+								if aws.ToString(v.ResourceRecordSet.Name) == "invalid." {
+									return middleware.InitializeOutput{}, middleware.Metadata{}, tt.err
+								}
+							}
+
+							return middleware.InitializeOutput{
+								Result: &route53.ChangeResourceRecordSetsOutput{
+									ChangeInfo: &awstypes.ChangeInfo{Id: aws.String("C123456")},
+								},
+							}, middleware.Metadata{}, nil
+						}), middleware.Before)
+					},
+				},
+			})
+
+			requests := []*changeRequest{newRequest("valid1."), newRequest("invalid."), newRequest("valid2.")}
+			b := &changeBatcher{
+				key:      changeBatcherKey{conn: conn, zoneID: "Z123456"},
+				inFlight: true,
+			}
+
+			b.execute(requests)
+
+			if got, want := calls.Load(), tt.wantCalls; got != want {
+				t.Errorf("calls = %d, want %d", got, want)
+			}
+			for i, v := range requests {
+				<-v.done
+
+				if got, want := v.err != nil, tt.wantErrs[i]; got != want {
+					t.Errorf("request %d error = %v, want error %t", i, v.err, want)
+				}
+				if v.err == nil && v.waiter == nil {
+					t.Errorf("request %d has no waiter", i)
+				}
+				if v.err != nil && !tt.isErr(v.err) {
+					t.Errorf("request %d error = %v, want %T", i, v.err, tt.err)
+				}
+			}
+		})
+	}
+}
@@ -430,22 +430,14 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	} else {
 		action = awstypes.ChangeActionCreate
 	}
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch: &awstypes.ChangeBatch{
-			Changes: []awstypes.Change{
-				{
-					Action:            action,
-					ResourceRecordSet: expandResourceRecordSet(d, aws.ToString(zoneRecord.HostedZone.Name)),
-				},
-			},
-			Comment: aws.String("Managed by Terraform"),
+	changes := []awstypes.Change{
+		{
+			Action:            action,
+			ResourceRecordSet: expandResourceRecordSet(d, aws.ToString(zoneRecord.HostedZone.Name)),
 		},
-		HostedZoneId: aws.String(cleanZoneID(aws.ToString(zoneRecord.HostedZone.Id))),
 	}
 
-	outputRaw, err := tfresource.RetryWhenIsA[*awstypes.NoSuchHostedZone](ctx, 1*time.Minute, func() (any, error) {
-		return conn.ChangeResourceRecordSets(ctx, input)
-	})
+	waiter, err := changeResourceRecordSets(ctx, conn, cleanZoneID(aws.ToString(zoneRecord.HostedZone.Id)), "Managed by Terraform", changes)
 
 	if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
 		err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
@@ -465,10 +457,8 @@ func resourceRecordCreate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 	d.SetId(strings.Join(vars, "_"))
 
-	if output := outputRaw.(*route53.ChangeResourceRecordSetsOutput); output.ChangeInfo != nil {
-		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), d.Timeout(schema.TimeoutCreate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) synchronize: %s", d.Id(), err)
-		}
+	if _, err := waiter.wait(ctx, d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) synchronize: %s", d.Id(), err)
 	}
 
 	return append(diags, resourceRecordRead(ctx, d, meta)...)
@@ -736,24 +726,18 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 	}
 
 	// Delete the old and create the new records in a single batch.
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch: &awstypes.ChangeBatch{
-			Changes: []awstypes.Change{
-				{
-					Action:            awstypes.ChangeActionDelete,
-					ResourceRecordSet: oldRec,
-				},
-				{
-					Action:            awstypes.ChangeActionCreate,
-					ResourceRecordSet: expandResourceRecordSet(d, aws.ToString(zoneRecord.HostedZone.Name)),
-				},
-			},
-			Comment: aws.String("Managed by Terraform"),
+	changes := []awstypes.Change{
+		{
+			Action:            awstypes.ChangeActionDelete,
+			ResourceRecordSet: oldRec,
+		},
+		{
+			Action:            awstypes.ChangeActionCreate,
+			ResourceRecordSet: expandResourceRecordSet(d, aws.ToString(zoneRecord.HostedZone.Name)),
 		},
-		HostedZoneId: aws.String(cleanZoneID(aws.ToString(zoneRecord.HostedZone.Id))),
 	}
 
-	output, err := conn.ChangeResourceRecordSets(ctx, input)
+	waiter, err := changeResourceRecordSets(ctx, conn, cleanZoneID(aws.ToString(zoneRecord.HostedZone.Id)), "Managed by Terraform", changes)
 
 	if v, ok := errs.As[*awstypes.InvalidChangeBatch](err); ok && len(v.Messages) > 0 {
 		err = fmt.Errorf("%s: %w", v.ErrorCode(), errors.Join(tfslices.ApplyToAll(v.Messages, errors.New)...))
@@ -763,10 +747,8 @@ func resourceRecordUpdate(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "updating Route53 Record (%s): %s", d.Id(), err)
 	}
 
-	if output.ChangeInfo != nil {
-		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), d.Timeout(schema.TimeoutUpdate)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) synchronize: %s", d.Id(), err)
-		}
+	if _, err := waiter.wait(ctx, d.Timeout(schema.TimeoutUpdate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) synchronize: %s", d.Id(), err)
 	}
 
 	// Generate a new ID.
@@ -807,20 +789,14 @@ func resourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "reading Route 53 Record (%s): %s", d.Id(), err)
 	}
 
-	input := &route53.ChangeResourceRecordSetsInput{
-		ChangeBatch: &awstypes.ChangeBatch{
-			Changes: []awstypes.Change{
-				{
-					Action:            awstypes.ChangeActionDelete,
-					ResourceRecordSet: rec,
-				},
-			},
-			Comment: aws.String("Deleted by Terraform"),
+	changes := []awstypes.Change{
+		{
+			Action:            awstypes.ChangeActionDelete,
+			ResourceRecordSet: rec,
 		},
-		HostedZoneId: aws.String(zoneID),
 	}
 
-	output, err := conn.ChangeResourceRecordSets(ctx, input)
+	waiter, err := changeResourceRecordSets(ctx, conn, zoneID, "Deleted by Terraform", changes)
 
 	// Pre-AWS SDK for Go v2 migration compatibility.
 	// https://github.com/hashicorp/terraform-provider-aws/issues/37806.
@@ -832,10 +808,8 @@ func resourceRecordDelete(ctx context.Context, d *schema.ResourceData, meta any)
 		return sdkdiag.AppendErrorf(diags, "deleting Route53 Record (%s): %s", d.Id(), err)
 	}
 
-	if output.ChangeInfo != nil {
-		if _, err := waitChangeInsync(ctx, conn, aws.ToString(output.ChangeInfo.Id), d.Timeout(schema.TimeoutDelete)); err != nil {
-			return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) synchronize: %s", d.Id(), err)
-		}
+	if _, err := waiter.wait(ctx, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for Route 53 Record (%s) synchronize: %s", d.Id(), err)
 	}
 
 	return diags
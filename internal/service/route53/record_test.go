@@ -290,6 +290,41 @@ func TestAccRoute53Record_Disappears_multipleRecords(t *testing.T) {
 	})
 }
 
+func TestAccRoute53Record_batchedInvalidChange(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.ResourceRecordSet
+	zoneName := acctest.RandomDomain()
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.Route53ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRecordDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRecordConfig_batched(zoneName.String(), 5, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, "aws_route53_record.test.0", &v),
+					testAccCheckRecordExists(ctx, "aws_route53_record.test.4", &v),
+				),
+			},
+			{
+				// Only the conflicting record fails, the records submitted in the same batch are created.
+				Config:      testAccRecordConfig_batched(zoneName.String(), 10, true),
+				ExpectError: regexache.MustCompile(`already exists`),
+			},
+			{
+				RefreshState:       true,
+				ExpectNonEmptyPlan: true,
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckRecordExists(ctx, "aws_route53_record.test.5", &v),
+					testAccCheckRecordExists(ctx, "aws_route53_record.test.9", &v),
+				),
+			},
+		},
+	})
+}
+
 func TestAccRoute53Record_underscored(t *testing.T) {
 	ctx := acctest.Context(t)
 	var record1 awstypes.ResourceRecordSet
@@ -2083,6 +2118,38 @@ resource "aws_route53_record" "test" {
 `, zoneName)
 }
 
+func testAccRecordConfig_batched(zoneName string, count int, conflict bool) string {
+	config := fmt.Sprintf(`
+resource "aws_route53_zone" "test" {
+  name = %[1]q
+}
+
+resource "aws_route53_record" "test" {
+  count = %[2]d
+
+  name    = "record${count.index}.${aws_route53_zone.test.name}"
+  records = ["127.0.0.${count.index}"]
+  ttl     = "30"
+  type    = "A"
+  zone_id = aws_route53_zone.test.zone_id
+}
+`, zoneName, count)
+
+	if conflict {
+		config += `
+resource "aws_route53_record" "conflict" {
+  name    = "record0.${aws_route53_zone.test.name}"
+  records = ["127.0.1.0"]
+  ttl     = "30"
+  type    = "A"
+  zone_id = aws_route53_zone.test.zone_id
+}
+`
+	}
+
+	return config
+}
+
 const testAccRecordConfig_nameTrailingPeriod = `
 resource "aws_route53_zone" "main" {
   name = "domain.test"
@@ -0,0 +1,637 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int32validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_metadata_configuration", name="Bucket Metadata Configuration")
+func newBucketMetadataConfigurationResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &bucketMetadataConfigurationResource{}
+
+	r.SetDefaultCreateTimeout(30 * time.Minute)
+	r.SetDefaultUpdateTimeout(30 * time.Minute)
+
+	return r, nil
+}
+
+type bucketMetadataConfigurationResource struct {
+	framework.ResourceWithModel[bucketMetadataConfigurationResourceModel]
+	framework.WithTimeouts
+}
+
+func (r *bucketMetadataConfigurationResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	encryptionConfigurationBlock := func(planModifiers ...planmodifier.List) schema.ListNestedBlock {
+		return schema.ListNestedBlock{
+			CustomType: fwtypes.NewListNestedObjectTypeOf[metadataTableEncryptionConfigurationModel](ctx),
+			Validators: []validator.List{
+				listvalidator.SizeAtMost(1),
+			},
+			PlanModifiers: planModifiers,
+			NestedObject: schema.NestedBlockObject{
+				Attributes: map[string]schema.Attribute{
+					names.AttrKMSKeyARN: schema.StringAttribute{
+						CustomType: fwtypes.ARNType,
+						Optional:   true,
+					},
+					"sse_algorithm": schema.StringAttribute{
+						CustomType: fwtypes.StringEnumType[awstypes.TableSseAlgorithm](),
+						Required:   true,
+					},
+				},
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 63),
+				},
+			},
+			names.AttrExpectedBucketOwner: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					fwvalidators.AWSAccountID(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"metadata_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[metadataConfigurationModel](ctx),
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrDestination: framework.ResourceComputedListOfObjectsAttribute[destinationResultModel](ctx, listplanmodifier.UseStateForUnknown()),
+					},
+					Blocks: map[string]schema.Block{
+						"inventory_table_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[inventoryTableConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"configuration_state": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.InventoryConfigurationState](),
+										Required:   true,
+									},
+									"table_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Computed:   true,
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Computed: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrEncryptionConfiguration: encryptionConfigurationBlock(),
+								},
+							},
+						},
+						"journal_table_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[journalTableConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"table_arn": schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Computed:   true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Computed: true,
+										PlanModifiers: []planmodifier.String{
+											stringplanmodifier.UseStateForUnknown(),
+										},
+									},
+								},
+								Blocks: map[string]schema.Block{
+									// The journal table's encryption can't be changed after it is created.
+									names.AttrEncryptionConfiguration: encryptionConfigurationBlock(listplanmodifier.RequiresReplace()),
+									"record_expiration": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[recordExpirationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"days": schema.Int32Attribute{
+													Optional: true,
+													Validators: []validator.Int32{
+														int32validator.AtLeast(7),
+													},
+												},
+												"expiration": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.ExpirationState](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *bucketMetadataConfigurationResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data bucketMetadataConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	var input s3.CreateBucketMetadataConfigurationInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := tfresource.RetryWhenAWSErrCodeEquals(ctx, bucketPropagationTimeout, func() (any, error) {
+		return conn.CreateBucketMetadataConfiguration(ctx, &input)
+	}, errCodeNoSuchBucket)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Bucket (%s) Metadata Configuration", bucket), err.Error())
+
+		return
+	}
+
+	output, err := waitBucketMetadataConfigurationActive(ctx, conn, bucket, expectedBucketOwner, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket (%s) Metadata Configuration create", bucket), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketMetadataConfigurationResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data bucketMetadataConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	output, err := findBucketMetadataConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Bucket (%s) Metadata Configuration", bucket), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *bucketMetadataConfigurationResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old bucketMetadataConfigurationResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, expectedBucketOwner := new.Bucket.ValueString(), new.ExpectedBucketOwner.ValueString()
+
+	newMetadataConfiguration, d := new.MetadataConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	oldMetadataConfiguration, d := old.MetadataConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	newInventoryTableConfiguration, d := newMetadataConfiguration.InventoryTableConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	oldInventoryTableConfiguration, d := oldMetadataConfiguration.InventoryTableConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !newInventoryTableConfiguration.ConfigurationState.Equal(oldInventoryTableConfiguration.ConfigurationState) || !newInventoryTableConfiguration.EncryptionConfiguration.Equal(oldInventoryTableConfiguration.EncryptionConfiguration) {
+		input := s3.UpdateBucketMetadataInventoryTableConfigurationInput{
+			Bucket:                      aws.String(bucket),
+			InventoryTableConfiguration: &awstypes.InventoryTableConfigurationUpdates{},
+		}
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, newInventoryTableConfiguration, input.InventoryTableConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateBucketMetadataInventoryTableConfiguration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) Metadata Configuration inventory table", bucket), err.Error())
+
+			return
+		}
+	}
+
+	newJournalTableConfiguration, d := newMetadataConfiguration.JournalTableConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	oldJournalTableConfiguration, d := oldMetadataConfiguration.JournalTableConfiguration.ToPtr(ctx)
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if !newJournalTableConfiguration.RecordExpiration.Equal(oldJournalTableConfiguration.RecordExpiration) {
+		input := s3.UpdateBucketMetadataJournalTableConfigurationInput{
+			Bucket:                    aws.String(bucket),
+			JournalTableConfiguration: &awstypes.JournalTableConfigurationUpdates{},
+		}
+		if expectedBucketOwner != "" {
+			input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+		}
+		response.Diagnostics.Append(fwflex.Expand(ctx, newJournalTableConfiguration, input.JournalTableConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateBucketMetadataJournalTableConfiguration(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Bucket (%s) Metadata Configuration journal table", bucket), err.Error())
+
+			return
+		}
+	}
+
+	output, err := waitBucketMetadataConfigurationActive(ctx, conn, bucket, expectedBucketOwner, r.UpdateTimeout(ctx, new.Timeouts))
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket (%s) Metadata Configuration update", bucket), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(new.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *bucketMetadataConfigurationResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data bucketMetadataConfigurationResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3Client(ctx)
+
+	bucket, expectedBucketOwner := data.Bucket.ValueString(), data.ExpectedBucketOwner.ValueString()
+	input := s3.DeleteBucketMetadataConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	// The journal and inventory tables are retained in the AWS managed table bucket.
+	_, err := conn.DeleteBucketMetadataConfiguration(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataConfigurationNotFound) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Bucket (%s) Metadata Configuration", bucket), err.Error())
+
+		return
+	}
+
+	_, err = tfresource.RetryUntilNotFound(ctx, bucketPropagationTimeout, func(ctx context.Context) (any, error) {
+		return findBucketMetadataConfiguration(ctx, conn, bucket, expectedBucketOwner)
+	})
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for S3 Bucket (%s) Metadata Configuration delete", bucket), err.Error())
+
+		return
+	}
+}
+
+func (r *bucketMetadataConfigurationResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	bucket, expectedBucketOwner, err := parseResourceID(request.ID)
+	if err != nil {
+		response.Diagnostics.Append(fwdiag.NewParsingResourceIDErrorDiagnostic(err))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrBucket), bucket)...)
+	if expectedBucketOwner != "" {
+		response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root(names.AttrExpectedBucketOwner), expectedBucketOwner)...)
+	}
+}
+
+func findBucketMetadataConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) (*awstypes.MetadataConfigurationResult, error) {
+	input := s3.GetBucketMetadataConfigurationInput{
+		Bucket: aws.String(bucket),
+	}
+	if expectedBucketOwner != "" {
+		input.ExpectedBucketOwner = aws.String(expectedBucketOwner)
+	}
+
+	output, err := conn.GetBucketMetadataConfiguration(ctx, &input)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket, errCodeMetadataConfigurationNotFound) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.GetBucketMetadataConfigurationResult == nil || output.GetBucketMetadataConfigurationResult.MetadataConfigurationResult == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.GetBucketMetadataConfigurationResult.MetadataConfigurationResult, nil
+}
+
+// statusBucketMetadataConfiguration returns the status of the journal table, or of the inventory table
+// while the inventory table is not yet active.
+func statusBucketMetadataConfiguration(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string) retry.StateRefreshFunc {
+	return func() (any, string, error) {
+		output, err := findBucketMetadataConfiguration(ctx, conn, bucket, expectedBucketOwner)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		var status string
+		if v := output.JournalTableConfigurationResult; v != nil {
+			status = aws.ToString(v.TableStatus)
+		}
+		if v := output.InventoryTableConfigurationResult; v != nil && v.ConfigurationState == awstypes.InventoryConfigurationStateEnabled {
+			if v := aws.ToString(v.TableStatus); status == metadataTableStatusActive && v != metadataTableStatusActive {
+				status = v
+			}
+		}
+
+		return output, status, nil
+	}
+}
+
+func waitBucketMetadataConfigurationActive(ctx context.Context, conn *s3.Client, bucket, expectedBucketOwner string, timeout time.Duration) (*awstypes.MetadataConfigurationResult, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: []string{metadataTableStatusBackfilling, metadataTableStatusCreating},
+		Target:  []string{metadataTableStatusActive},
+		Refresh: statusBucketMetadataConfiguration(ctx, conn, bucket, expectedBucketOwner),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.MetadataConfigurationResult); ok {
+		var errs []error
+		if v := output.JournalTableConfigurationResult; v != nil && v.Error != nil {
+			errs = append(errs, fmt.Errorf("journal table: %s: %s", aws.ToString(v.Error.ErrorCode), aws.ToString(v.Error.ErrorMessage)))
+		}
+		if v := output.InventoryTableConfigurationResult; v != nil && v.Error != nil {
+			errs = append(errs, fmt.Errorf("inventory table: %s: %s", aws.ToString(v.Error.ErrorCode), aws.ToString(v.Error.ErrorMessage)))
+		}
+		tfresource.SetLastError(err, errors.Join(errs...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+const (
+	metadataTableStatusActive      = "ACTIVE"
+	metadataTableStatusBackfilling = "BACKFILLING"
+	metadataTableStatusCreating    = "CREATING"
+)
+
+type bucketMetadataConfigurationResourceModel struct {
+	framework.WithRegionModel
+	Bucket                types.String                                                `tfsdk:"bucket"`
+	ExpectedBucketOwner   types.String                                                `tfsdk:"expected_bucket_owner"`
+	MetadataConfiguration fwtypes.ListNestedObjectValueOf[metadataConfigurationModel] `tfsdk:"metadata_configuration"`
+	Timeouts              timeouts.Value                                              `tfsdk:"timeouts"`
+}
+
+// flatten sets the values returned by the API.
+// The tables' encryption configuration is not returned and is kept from the plan or prior state.
+func (data *bucketMetadataConfigurationResourceModel) flatten(ctx context.Context, apiObject *awstypes.MetadataConfigurationResult) (diags diag.Diagnostics) {
+	metadataConfiguration, d := data.MetadataConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+	if metadataConfiguration == nil {
+		// Import.
+		metadataConfiguration = &metadataConfigurationModel{
+			InventoryTableConfiguration: fwtypes.NewListNestedObjectValueOfNull[inventoryTableConfigurationModel](ctx),
+			JournalTableConfiguration:   fwtypes.NewListNestedObjectValueOfNull[journalTableConfigurationModel](ctx),
+		}
+	}
+
+	var destination destinationResultModel
+	diags.Append(fwflex.Flatten(ctx, apiObject.DestinationResult, &destination)...)
+	if diags.HasError() {
+		return diags
+	}
+	metadataConfiguration.Destination = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &destination)
+
+	if v := apiObject.InventoryTableConfigurationResult; v != nil {
+		inventoryTableConfiguration, d := metadataConfiguration.InventoryTableConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if inventoryTableConfiguration == nil {
+			inventoryTableConfiguration = &inventoryTableConfigurationModel{
+				EncryptionConfiguration: fwtypes.NewListNestedObjectValueOfNull[metadataTableEncryptionConfigurationModel](ctx),
+			}
+		}
+
+		inventoryTableConfiguration.ConfigurationState = fwtypes.StringEnumValue(v.ConfigurationState)
+		inventoryTableConfiguration.TableARN = fwflex.StringToFrameworkARN(ctx, v.TableArn)
+		inventoryTableConfiguration.TableName = fwflex.StringToFramework(ctx, v.TableName)
+		metadataConfiguration.InventoryTableConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, inventoryTableConfiguration)
+	}
+
+	if v := apiObject.JournalTableConfigurationResult; v != nil {
+		journalTableConfiguration, d := metadataConfiguration.JournalTableConfiguration.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+		if journalTableConfiguration == nil {
+			journalTableConfiguration = &journalTableConfigurationModel{
+				EncryptionConfiguration: fwtypes.NewListNestedObjectValueOfNull[metadataTableEncryptionConfigurationModel](ctx),
+			}
+		}
+
+		var recordExpiration recordExpirationModel
+		diags.Append(fwflex.Flatten(ctx, v.RecordExpiration, &recordExpiration)...)
+		if diags.HasError() {
+			return diags
+		}
+		journalTableConfiguration.RecordExpiration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &recordExpiration)
+		journalTableConfiguration.TableARN = fwflex.StringToFrameworkARN(ctx, v.TableArn)
+		journalTableConfiguration.TableName = fwflex.StringToFramework(ctx, v.TableName)
+		metadataConfiguration.JournalTableConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, journalTableConfiguration)
+	}
+
+	data.MetadataConfiguration = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, metadataConfiguration)
+
+	return diags
+}
+
+type metadataConfigurationModel struct {
+	Destination                 fwtypes.ListNestedObjectValueOf[destinationResultModel]           `tfsdk:"destination"`
+	InventoryTableConfiguration fwtypes.ListNestedObjectValueOf[inventoryTableConfigurationModel] `tfsdk:"inventory_table_configuration"`
+	JournalTableConfiguration   fwtypes.ListNestedObjectValueOf[journalTableConfigurationModel]   `tfsdk:"journal_table_configuration"`
+}
+
+type destinationResultModel struct {
+	TableBucketARN  fwtypes.ARN                                     `tfsdk:"table_bucket_arn"`
+	TableBucketType fwtypes.StringEnum[awstypes.S3TablesBucketType] `tfsdk:"table_bucket_type"`
+	TableNamespace  types.String                                    `tfsdk:"table_namespace"`
+}
+
+type inventoryTableConfigurationModel struct {
+	ConfigurationState      fwtypes.StringEnum[awstypes.InventoryConfigurationState]                   `tfsdk:"configuration_state"`
+	EncryptionConfiguration fwtypes.ListNestedObjectValueOf[metadataTableEncryptionConfigurationModel] `tfsdk:"encryption_configuration"`
+	TableARN                fwtypes.ARN                                                                `tfsdk:"table_arn"`
+	TableName               types.String                                                               `tfsdk:"table_name"`
+}
+
+type journalTableConfigurationModel struct {
+	EncryptionConfiguration fwtypes.ListNestedObjectValueOf[metadataTableEncryptionConfigurationModel] `tfsdk:"encryption_configuration"`
+	RecordExpiration        fwtypes.ListNestedObjectValueOf[recordExpirationModel]                     `tfsdk:"record_expiration"`
+	TableARN                fwtypes.ARN                                                                `tfsdk:"table_arn"`
+	TableName               types.String                                                               `tfsdk:"table_name"`
+}
+
+type metadataTableEncryptionConfigurationModel struct {
+	KMSKeyARN    fwtypes.ARN                                    `tfsdk:"kms_key_arn"`
+	SSEAlgorithm fwtypes.StringEnum[awstypes.TableSseAlgorithm] `tfsdk:"sse_algorithm"`
+}
+
+type recordExpirationModel struct {
+	Days       types.Int32                                  `tfsdk:"days"`
+	Expiration fwtypes.StringEnum[awstypes.ExpirationState] `tfsdk:"expiration"`
+}
@@ -0,0 +1,312 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketMetadataConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_metadata_configuration.test"
+	var v awstypes.MetadataConfigurationResult
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.destination.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_configuration.0.destination.0.table_bucket_arn"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.destination.0.table_bucket_type", "aws"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_configuration.0.destination.0.table_namespace"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.inventory_table_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.inventory_table_configuration.0.configuration_state", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.journal_table_configuration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.journal_table_configuration.0.record_expiration.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.journal_table_configuration.0.record_expiration.0.expiration", "DISABLED"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_configuration.0.journal_table_configuration.0.table_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_configuration.0.journal_table_configuration.0.table_name"),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrBucket),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrBucket,
+				ImportStateVerifyIgnore:              []string{names.AttrTimeouts},
+			},
+			{
+				// The tables are retained and are removed so that they don't accumulate.
+				Config: testAccBucketMetadataConfigurationConfig_base(rName),
+				Check:  testAccDeleteBucketMetadataConfigurationTables(ctx, &v),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketMetadataConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_metadata_configuration.test"
+	var v awstypes.MetadataConfigurationResult
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3.ResourceBucketMetadataConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketMetadataConfigurationConfig_base(rName),
+				Check:  testAccDeleteBucketMetadataConfigurationTables(ctx, &v),
+			},
+		},
+	})
+}
+
+func TestAccS3BucketMetadataConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_metadata_configuration.test"
+	var v awstypes.MetadataConfigurationResult
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketMetadataConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketMetadataConfigurationConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.inventory_table_configuration.0.configuration_state", "DISABLED"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.journal_table_configuration.0.record_expiration.0.expiration", "DISABLED"),
+				),
+			},
+			{
+				Config: testAccBucketMetadataConfigurationConfig_inventoryAndExpiration(rName, 30),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.inventory_table_configuration.0.configuration_state", "ENABLED"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.inventory_table_configuration.0.encryption_configuration.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "metadata_configuration.0.inventory_table_configuration.0.encryption_configuration.0.kms_key_arn", "aws_kms_key.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.inventory_table_configuration.0.encryption_configuration.0.sse_algorithm", "aws:kms"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_configuration.0.inventory_table_configuration.0.table_arn"),
+					resource.TestCheckResourceAttrSet(resourceName, "metadata_configuration.0.inventory_table_configuration.0.table_name"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.journal_table_configuration.0.record_expiration.0.days", "30"),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.journal_table_configuration.0.record_expiration.0.expiration", "ENABLED"),
+				),
+			},
+			{
+				Config: testAccBucketMetadataConfigurationConfig_inventoryAndExpiration(rName, 60),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckBucketMetadataConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "metadata_configuration.0.journal_table_configuration.0.record_expiration.0.days", "60"),
+				),
+			},
+			{
+				Config: testAccBucketMetadataConfigurationConfig_inventoryAndExpirationBase(rName),
+				Check:  testAccDeleteBucketMetadataConfigurationTables(ctx, &v),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketMetadataConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3_bucket_metadata_configuration" {
+				continue
+			}
+
+			_, err := tfs3.FindBucketMetadataConfiguration(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Bucket Metadata Configuration %s still exists", rs.Primary.Attributes[names.AttrBucket])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketMetadataConfigurationExists(ctx context.Context, n string, v *awstypes.MetadataConfigurationResult) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		output, err := tfs3.FindBucketMetadataConfiguration(ctx, conn, rs.Primary.Attributes[names.AttrBucket], rs.Primary.Attributes[names.AttrExpectedBucketOwner])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccDeleteBucketMetadataConfigurationTables(ctx context.Context, v *awstypes.MetadataConfigurationResult) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		if v.DestinationResult == nil {
+			return nil
+		}
+
+		var tableNames []*string
+		if v := v.JournalTableConfigurationResult; v != nil {
+			tableNames = append(tableNames, v.TableName)
+		}
+		if v := v.InventoryTableConfigurationResult; v != nil && v.TableName != nil {
+			tableNames = append(tableNames, v.TableName)
+		}
+
+		for _, tableName := range tableNames {
+			input := s3tables.DeleteTableInput{
+				Name:           tableName,
+				Namespace:      v.DestinationResult.TableNamespace,
+				TableBucketARN: v.DestinationResult.TableBucketArn,
+			}
+
+			if _, err := conn.DeleteTable(ctx, &input); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccBucketMetadataConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+`, rName)
+}
+
+func testAccBucketMetadataConfigurationConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetadataConfigurationConfig_base(rName), `
+resource "aws_s3_bucket_metadata_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  metadata_configuration {
+    inventory_table_configuration {
+      configuration_state = "DISABLED"
+    }
+
+    journal_table_configuration {
+      record_expiration {
+        expiration = "DISABLED"
+      }
+    }
+  }
+}
+`)
+}
+
+func testAccBucketMetadataConfigurationConfig_inventoryAndExpirationBase(rName string) string {
+	return acctest.ConfigCompose(testAccBucketMetadataConfigurationConfig_base(rName), fmt.Sprintf(`
+data "aws_caller_identity" "current" {}
+
+data "aws_partition" "current" {}
+
+resource "aws_kms_key" "test" {
+  description             = %[1]q
+  deletion_window_in_days = 7
+  enable_key_rotation     = true
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [
+      {
+        Effect    = "Allow"
+        Principal = { AWS = "arn:${data.aws_partition.current.partition}:iam::${data.aws_caller_identity.current.account_id}:root" }
+        Action    = "kms:*"
+        Resource  = "*"
+      },
+      {
+        Effect    = "Allow"
+        Principal = { Service = "maintenance.s3tables.amazonaws.com" }
+        Action    = ["kms:Decrypt", "kms:GenerateDataKey"]
+        Resource  = "*"
+      },
+    ]
+  })
+}
+`, rName))
+}
+
+func testAccBucketMetadataConfigurationConfig_inventoryAndExpiration(rName string, days int) string {
+	return acctest.ConfigCompose(testAccBucketMetadataConfigurationConfig_inventoryAndExpirationBase(rName), fmt.Sprintf(`
+resource "aws_s3_bucket_metadata_configuration" "test" {
+  bucket = aws_s3_bucket.test.bucket
+
+  metadata_configuration {
+    inventory_table_configuration {
+      configuration_state = "ENABLED"
+
+      encryption_configuration {
+        kms_key_arn   = aws_kms_key.test.arn
+        sse_algorithm = "aws:kms"
+      }
+    }
+
+    journal_table_configuration {
+      record_expiration {
+        days       = %[1]d
+        expiration = "ENABLED"
+      }
+    }
+  }
+}
+`, days))
+}
@@ -0,0 +1,219 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_s3_bucket_notification_targets_exclusive", name="Bucket Notification Targets Exclusive")
+func newBucketNotificationTargetsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &bucketNotificationTargetsExclusiveResource{}, nil
+}
+
+const (
+	ResNameBucketNotificationTargetsExclusive = "Bucket Notification Targets Exclusive"
+)
+
+type bucketNotificationTargetsExclusiveResource struct {
+	framework.ResourceWithModel[bucketNotificationTargetsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *bucketNotificationTargetsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+		},
+	}
+}
+
+func (r *bucketNotificationTargetsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan bucketNotificationTargetsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var targetARNs []string
+	resp.Diagnostics.Append(plan.TargetARNs.ElementsAs(ctx, &targetARNs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncTargets(ctx, plan.Bucket.ValueString(), targetARNs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3, create.ErrActionCreating, ResNameBucketNotificationTargetsExclusive, plan.Bucket.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *bucketNotificationTargetsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().S3Client(ctx)
+
+	var state bucketNotificationTargetsExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findBucketNotificationConfiguration(ctx, conn, state.Bucket.ValueString(), "")
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.S3, create.ErrActionReading, ResNameBucketNotificationTargetsExclusive, state.Bucket.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.TargetARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, notificationTargetARNs(out))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *bucketNotificationTargetsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state bucketNotificationTargetsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.TargetARNs.Equal(state.TargetARNs) {
+		var targetARNs []string
+		resp.Diagnostics.Append(plan.TargetARNs.ElementsAs(ctx, &targetARNs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncTargets(ctx, plan.Bucket.ValueString(), targetARNs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.S3, create.ErrActionUpdating, ResNameBucketNotificationTargetsExclusive, plan.Bucket.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncTargets handles keeping the configured notification targets in
+// sync with the remote resource.
+//
+// Lambda function, SQS queue and SNS topic notification configurations
+// with a target not configured on this resource will be removed. Configured
+// targets which do not exist cannot be created by this resource and will
+// return an error. The EventBridge configuration is left unchanged.
+func (r *bucketNotificationTargetsExclusiveResource) syncTargets(ctx context.Context, bucket string, want []string) error {
+	conn := r.Meta().S3Client(ctx)
+
+	out, err := findBucketNotificationConfiguration(ctx, conn, bucket, "")
+	if err != nil {
+		return err
+	}
+
+	have := notificationTargetARNs(out)
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		return fmt.Errorf("notification targets not found: %v", missing)
+	}
+
+	if len(remove) == 0 {
+		return nil
+	}
+
+	input := s3.PutBucketNotificationConfigurationInput{
+		Bucket: aws.String(bucket),
+		NotificationConfiguration: &awstypes.NotificationConfiguration{
+			EventBridgeConfiguration: out.EventBridgeConfiguration,
+			LambdaFunctionConfigurations: slices.DeleteFunc(out.LambdaFunctionConfigurations, func(v awstypes.LambdaFunctionConfiguration) bool {
+				return slices.Contains(remove, aws.ToString(v.LambdaFunctionArn))
+			}),
+			QueueConfigurations: slices.DeleteFunc(out.QueueConfigurations, func(v awstypes.QueueConfiguration) bool {
+				return slices.Contains(remove, aws.ToString(v.QueueArn))
+			}),
+			TopicConfigurations: slices.DeleteFunc(out.TopicConfigurations, func(v awstypes.TopicConfiguration) bool {
+				return slices.Contains(remove, aws.ToString(v.TopicArn))
+			}),
+		},
+	}
+
+	_, err = conn.PutBucketNotificationConfiguration(ctx, &input)
+
+	return err
+}
+
+func (r *bucketNotificationTargetsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrBucket), req, resp)
+}
+
+// notificationTargetARNs returns the distinct Lambda function, SQS queue and SNS topic ARNs that a bucket sends notifications to.
+func notificationTargetARNs(output *s3.GetBucketNotificationConfigurationOutput) []string {
+	var targetARNs []string
+
+	for _, v := range output.LambdaFunctionConfigurations {
+		targetARNs = append(targetARNs, aws.ToString(v.LambdaFunctionArn))
+	}
+	for _, v := range output.QueueConfigurations {
+		targetARNs = append(targetARNs, aws.ToString(v.QueueArn))
+	}
+	for _, v := range output.TopicConfigurations {
+		targetARNs = append(targetARNs, aws.ToString(v.TopicArn))
+	}
+
+	slices.Sort(targetARNs)
+
+	return slices.Compact(targetARNs)
+}
+
+type bucketNotificationTargetsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	Bucket     types.String        `tfsdk:"bucket"`
+	TargetARNs fwtypes.SetOfString `tfsdk:"target_arns"`
+}
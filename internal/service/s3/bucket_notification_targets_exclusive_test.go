@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3BucketNotificationTargetsExclusive_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_targets_exclusive.test"
+	bucketResourceName := "aws_s3_bucket.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrBucket, bucketResourceName, names.AttrBucket),
+					resource.TestCheckResourceAttr(resourceName, "target_arns.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "target_arns.*", "aws_sns_topic.test", names.AttrARN),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrBucket),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrBucket,
+			},
+		},
+	})
+}
+
+func TestAccS3BucketNotificationTargetsExclusive_outOfBandAddition(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_bucket_notification_targets_exclusive.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketNotificationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccBucketNotificationTargetsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetsExclusiveExists(ctx, resourceName),
+					testAccCheckBucketNotificationAddTopic(ctx, "aws_s3_bucket.test", "aws_sns_topic.out_of_band"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testAccBucketNotificationTargetsExclusiveConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckBucketNotificationTargetsExclusiveExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "target_arns.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckBucketNotificationTargetsExclusiveExists(ctx context.Context, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return create.Error(names.S3, create.ErrActionCheckingExistence, tfs3.ResNameBucketNotificationTargetsExclusive, name, errors.New("not found"))
+		}
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		if bucket == "" {
+			return create.Error(names.S3, create.ErrActionCheckingExistence, tfs3.ResNameBucketNotificationTargetsExclusive, name, errors.New("not set"))
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+		out, err := tfs3.FindBucketNotificationConfiguration(ctx, conn, bucket, "")
+		if err != nil {
+			return create.Error(names.S3, create.ErrActionCheckingExistence, tfs3.ResNameBucketNotificationTargetsExclusive, bucket, err)
+		}
+
+		targetCount := rs.Primary.Attributes["target_arns.#"]
+		if targetCount != strconv.Itoa(len(tfs3.NotificationTargetARNs(out))) {
+			return create.Error(names.S3, create.ErrActionCheckingExistence, tfs3.ResNameBucketNotificationTargetsExclusive, bucket, errors.New("unexpected target_arns count"))
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckBucketNotificationAddTopic(ctx context.Context, bucketName, topicName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		bucket, ok := s.RootModule().Resources[bucketName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", bucketName)
+		}
+
+		topic, ok := s.RootModule().Resources[topicName]
+		if !ok {
+			return fmt.Errorf("Not found: %s", topicName)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		out, err := tfs3.FindBucketNotificationConfiguration(ctx, conn, bucket.Primary.ID, "")
+		if err != nil {
+			return err
+		}
+
+		input := s3.PutBucketNotificationConfigurationInput{
+			Bucket: aws.String(bucket.Primary.ID),
+			NotificationConfiguration: &types.NotificationConfiguration{
+				EventBridgeConfiguration:     out.EventBridgeConfiguration,
+				LambdaFunctionConfigurations: out.LambdaFunctionConfigurations,
+				QueueConfigurations:          out.QueueConfigurations,
+				TopicConfigurations: append(out.TopicConfigurations, types.TopicConfiguration{
+					Events:   []types.Event{types.EventS3ObjectRemoved},
+					TopicArn: aws.String(topic.Primary.Attributes[names.AttrARN]),
+				}),
+			},
+		}
+
+		_, err = conn.PutBucketNotificationConfiguration(ctx, &input)
+
+		return err
+	}
+}
+
+func testAccBucketNotificationTargetsExclusiveConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_iam_policy_document" "test" {
+  statement {
+    actions   = ["SNS:Publish"]
+    resources = ["*"]
+
+    principals {
+      type        = "Service"
+      identifiers = ["s3.amazonaws.com"]
+    }
+
+    condition {
+      test     = "ArnLike"
+      variable = "aws:SourceArn"
+      values   = [aws_s3_bucket.test.arn]
+    }
+  }
+}
+
+resource "aws_s3_bucket" "test" {
+  bucket = %[1]q
+}
+
+resource "aws_sns_topic" "test" {
+  name   = %[1]q
+  policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_sns_topic" "out_of_band" {
+  name   = "%[1]s-oob"
+  policy = data.aws_iam_policy_document.test.json
+}
+
+resource "aws_s3_bucket_notification" "test" {
+  bucket = aws_s3_bucket.test.id
+
+  topic {
+    topic_arn = aws_sns_topic.test.arn
+    events    = ["s3:ObjectCreated:*"]
+  }
+}
+
+resource "aws_s3_bucket_notification_targets_exclusive" "test" {
+  bucket      = aws_s3_bucket.test.bucket
+  target_arns = [aws_sns_topic.test.arn]
+
+  depends_on = [aws_s3_bucket_notification.test]
+}
+`, rName)
+}
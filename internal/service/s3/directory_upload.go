@@ -0,0 +1,701 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"maps"
+	"mime"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/feature/s3/manager"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3/types"
+	"github.com/hashicorp/aws-sdk-go-base/v2/tfawserr"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/mitchellh/go-homedir"
+)
+
+// @FrameworkResource("aws_s3_directory_upload", name="Directory Upload")
+func newDirectoryUploadResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &directoryUploadResource{}, nil
+}
+
+const (
+	defaultDirectoryUploadMaxConcurrency = 10
+	defaultDirectoryUploadMaxFiles       = 10000
+
+	// directoryUploadContentHashMetadataKey is the user metadata key that records the SHA-256 hash of an uploaded file.
+	// ETags can't be used to detect unchanged objects as they are not the content MD5 for encrypted or multipart objects.
+	directoryUploadContentHashMetadataKey = "terraform-content-sha256"
+)
+
+type directoryUploadResource struct {
+	framework.ResourceWithModel[directoryUploadResourceModel]
+}
+
+func (r *directoryUploadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrBucket: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"cache_control": schema.StringAttribute{
+				Optional: true,
+			},
+			"content_types": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"delete_removed": schema.BoolAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  booldefault.StaticBool(false),
+			},
+			"deleted_count": schema.Int64Attribute{
+				Computed: true,
+			},
+			"files": schema.MapAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+			},
+			"key_prefix": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				Default:  stringdefault.StaticString(""),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"max_concurrency": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultDirectoryUploadMaxConcurrency),
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"max_files": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				Default:  int64default.StaticInt64(defaultDirectoryUploadMaxFiles),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			names.AttrSource: schema.StringAttribute{
+				Required: true,
+			},
+			"source_hash": schema.StringAttribute{
+				Computed: true,
+			},
+			"uploaded_count": schema.Int64Attribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *directoryUploadResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	// Destroy.
+	if request.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan directoryUploadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &plan)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var state *directoryUploadResourceModel
+	if !request.State.Raw.IsNull() {
+		response.Diagnostics.Append(request.State.Get(ctx, &state)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if plan.Source.IsUnknown() || plan.KeyPrefix.IsUnknown() {
+		plan.DeletedCount = types.Int64Unknown()
+		plan.Files = types.MapUnknown(types.StringType)
+		plan.SourceHash = types.StringUnknown()
+		plan.UploadedCount = types.Int64Unknown()
+		response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+		return
+	}
+
+	source := plan.Source.ValueString()
+	files, err := hashDirectoryUploadSource(source, plan.KeyPrefix.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Upload source (%s)", source), err.Error())
+
+		return
+	}
+
+	// The hash of every file is recorded in state, so limit the state size.
+	if n, maxFiles := len(files), plan.MaxFiles.ValueInt64(); !plan.MaxFiles.IsUnknown() && int64(n) > maxFiles {
+		response.Diagnostics.AddAttributeError(path.Root("max_files"), "Too many files", fmt.Sprintf("S3 Directory Upload source (%s) contains %d files, more than max_files (%d)", source, n, maxFiles))
+
+		return
+	}
+
+	plan.Files = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, files)
+	plan.SourceHash = types.StringValue(directoryUploadSourceHash(files))
+
+	if state != nil && state.Files.Equal(plan.Files) && state.CacheControl.Equal(plan.CacheControl) && state.ContentTypes.Equal(plan.ContentTypes) {
+		plan.DeletedCount = state.DeletedCount
+		plan.UploadedCount = state.UploadedCount
+	} else {
+		plan.DeletedCount = types.Int64Unknown()
+		plan.UploadedCount = types.Int64Unknown()
+	}
+
+	response.Diagnostics.Append(response.Plan.Set(ctx, &plan)...)
+}
+
+func (r *directoryUploadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data directoryUploadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, keyPrefix := data.Bucket.ValueString(), directoryUploadKeyPrefix(data.KeyPrefix.ValueString())
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	want, err := r.currentFiles(ctx, &data)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	// Skip objects that are already present with matching content.
+	existing, err := findObjectKeysByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	have, err := findObjectContentHashes(ctx, conn, bucket, slices.DeleteFunc(slices.Collect(maps.Keys(want)), func(key string) bool {
+		_, ok := existing[key]
+		return !ok
+	}), data.MaxConcurrency.ValueInt64())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	var toUpload []string
+	for key, hash := range want {
+		if have[key] != hash {
+			toUpload = append(toUpload, key)
+		}
+	}
+
+	if err := r.uploadFiles(ctx, conn, &data, toUpload); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	data.DeletedCount = types.Int64Value(0)
+	data.UploadedCount = types.Int64Value(int64(len(toUpload)))
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryUploadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data directoryUploadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket, keyPrefix := data.Bucket.ValueString(), directoryUploadKeyPrefix(data.KeyPrefix.ValueString())
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	existing, err := findObjectKeysByPrefix(ctx, conn, bucket, keyPrefix)
+
+	if tfawserr.ErrCodeEquals(err, errCodeNoSuchBucket) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	// Record the content hash of each object as it is in the bucket, so that objects that have been
+	// removed or overwritten outside of Terraform no longer match the source and are uploaded again.
+	files := fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)
+	have, err := findObjectContentHashes(ctx, conn, bucket, slices.DeleteFunc(slices.Collect(maps.Keys(files)), func(key string) bool {
+		_, ok := existing[key]
+		return !ok
+	}), data.MaxConcurrency.ValueInt64())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	data.Files = fwflex.FlattenFrameworkStringValueMapLegacy(ctx, have)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *directoryUploadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new, old directoryUploadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := new.Bucket.ValueString()
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	want, err := r.currentFiles(ctx, &new)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	have := fwflex.ExpandFrameworkStringValueMap(ctx, old.Files)
+	// Object metadata can only be changed by uploading the object again.
+	uploadAll := !new.CacheControl.Equal(old.CacheControl) || !new.ContentTypes.Equal(old.ContentTypes)
+
+	var toUpload, toDelete []string
+	for key, hash := range want {
+		if uploadAll || have[key] != hash {
+			toUpload = append(toUpload, key)
+		}
+	}
+	for key := range have {
+		if _, ok := want[key]; !ok {
+			toDelete = append(toDelete, key)
+		}
+	}
+
+	if err := r.uploadFiles(ctx, conn, &new, toUpload); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+
+	var nDeleted int64
+	if new.DeleteRemoved.ValueBool() {
+		nDeleted, err = deleteDirectoryUploadObjects(ctx, conn, bucket, toDelete)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating S3 Directory Upload (%s)", bucket), err.Error())
+
+			return
+		}
+	}
+
+	// Counts are only recomputed when the plan expects the source or object metadata to change.
+	if new.DeletedCount.IsUnknown() {
+		new.DeletedCount = types.Int64Value(nDeleted)
+	}
+	if new.UploadedCount.IsUnknown() {
+		new.UploadedCount = types.Int64Value(int64(len(toUpload)))
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *directoryUploadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data directoryUploadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	bucket := data.Bucket.ValueString()
+	conn := r.Meta().S3Client(ctx)
+	if isDirectoryBucket(bucket) {
+		conn = r.Meta().S3ExpressClient(ctx)
+	}
+
+	keys := slices.Collect(maps.Keys(fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)))
+
+	if _, err := deleteDirectoryUploadObjects(ctx, conn, bucket, keys); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Directory Upload (%s)", bucket), err.Error())
+
+		return
+	}
+}
+
+// currentFiles hashes the source directory and verifies that it has not changed since the plan was made.
+func (r *directoryUploadResource) currentFiles(ctx context.Context, data *directoryUploadResourceModel) (map[string]string, error) {
+	source := data.Source.ValueString()
+	files, err := hashDirectoryUploadSource(source, data.KeyPrefix.ValueString())
+
+	if err != nil {
+		return nil, fmt.Errorf("reading source (%s): %w", source, err)
+	}
+
+	if !maps.Equal(files, fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)) {
+		return nil, fmt.Errorf("source (%s) changed after the plan was created", source)
+	}
+
+	return files, nil
+}
+
+// uploadFiles uploads the specified keys from the source directory, at most max_concurrency at a time.
+func (r *directoryUploadResource) uploadFiles(ctx context.Context, conn *s3.Client, data *directoryUploadResourceModel, keys []string) error {
+	bucket, keyPrefix, source := data.Bucket.ValueString(), directoryUploadKeyPrefix(data.KeyPrefix.ValueString()), data.Source.ValueString()
+	contentTypes := fwflex.ExpandFrameworkStringValueMap(ctx, data.ContentTypes)
+	want := fwflex.ExpandFrameworkStringValueMap(ctx, data.Files)
+	uploader := manager.NewUploader(conn)
+
+	root, err := homedir.Expand(source)
+	if err != nil {
+		return err
+	}
+
+	return forEachConcurrently(keys, data.MaxConcurrency.ValueInt64(), func(key string) error {
+		filename := filepath.Join(root, filepath.FromSlash(strings.TrimPrefix(key, keyPrefix)))
+		if err := uploadDirectoryUploadFile(ctx, uploader, bucket, key, filename, data.CacheControl.ValueString(), contentTypes, want[key]); err != nil {
+			return fmt.Errorf("uploading S3 Object (%s) to Bucket (%s): %w", key, bucket, err)
+		}
+
+		return nil
+	})
+}
+
+// forEachConcurrently calls f for each key, at most n at a time, and returns all errors.
+func forEachConcurrently(keys []string, n int64, f func(string) error) error {
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		errs []error
+	)
+	sem := make(chan struct{}, n)
+
+	for _, key := range keys {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func(key string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			if err := f(key); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(key)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func uploadDirectoryUploadFile(ctx context.Context, uploader *manager.Uploader, bucket, key, filename, cacheControl string, contentTypes map[string]string, hash string) error {
+	file, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	contentType, err := directoryUploadContentType(file, contentTypes)
+	if err != nil {
+		return err
+	}
+
+	input := s3.PutObjectInput{
+		Body:        file,
+		Bucket:      aws.String(bucket),
+		ContentType: aws.String(contentType),
+		Key:         aws.String(key),
+		Metadata: map[string]string{
+			directoryUploadContentHashMetadataKey: hash,
+		},
+	}
+	if cacheControl != "" {
+		input.CacheControl = aws.String(cacheControl)
+	}
+
+	_, err = uploader.Upload(ctx, &input)
+
+	return err
+}
+
+// directoryUploadContentType infers a file's content type from, in order, the configured overrides,
+// the file extension and the file content.
+func directoryUploadContentType(file *os.File, contentTypes map[string]string) (string, error) {
+	ext := strings.ToLower(filepath.Ext(file.Name()))
+
+	if v, ok := contentTypes[ext]; ok {
+		return v, nil
+	}
+
+	if v := mime.TypeByExtension(ext); v != "" {
+		return v, nil
+	}
+
+	buf := make([]byte, 512)
+	n, err := io.ReadFull(file, buf)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+
+	return http.DetectContentType(buf[:n]), nil
+}
+
+// hashDirectoryUploadSource returns the SHA-256 hash of each regular file below the source directory, keyed by object key.
+// Symbolic links to regular files are followed. Symbolic links to directories are skipped.
+func hashDirectoryUploadSource(source, keyPrefix string) (map[string]string, error) {
+	root, err := homedir.Expand(source)
+	if err != nil {
+		return nil, err
+	}
+
+	keyPrefix = directoryUploadKeyPrefix(keyPrefix)
+	files := make(map[string]string)
+
+	err = filepath.WalkDir(root, func(filename string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		switch mode := d.Type(); {
+		case mode&fs.ModeSymlink != 0:
+			fi, err := os.Stat(filename)
+			if err != nil {
+				return err
+			}
+
+			if !fi.Mode().IsRegular() {
+				return nil
+			}
+		case !mode.IsRegular():
+			return nil
+		}
+
+		rel, err := filepath.Rel(root, filename)
+		if err != nil {
+			return err
+		}
+
+		hash, err := fileSHA256(filename)
+		if err != nil {
+			return err
+		}
+
+		files[keyPrefix+filepath.ToSlash(rel)] = hash
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return files, nil
+}
+
+// directoryUploadKeyPrefix returns the key prefix with a trailing "/", so that object keys are
+// always the prefix and the relative path separated by a "/".
+func directoryUploadKeyPrefix(keyPrefix string) string {
+	if keyPrefix == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(keyPrefix, "/") + "/"
+}
+
+func fileSHA256(filename string) (string, error) {
+	file, err := os.Open(filename)
+	if err != nil {
+		return "", err
+	}
+	defer file.Close()
+
+	h := sha256.New()
+	if _, err := io.Copy(h, file); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// directoryUploadSourceHash returns a single hash summarizing the keys and content of all files.
+func directoryUploadSourceHash(files map[string]string) string {
+	h := sha256.New()
+
+	for _, key := range slices.Sorted(maps.Keys(files)) {
+		fmt.Fprintf(h, "%s\x00%s\n", key, files[key])
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// findObjectKeysByPrefix returns the keys of all objects with the specified key prefix.
+func findObjectKeysByPrefix(ctx context.Context, conn *s3.Client, bucket, keyPrefix string) (map[string]struct{}, error) {
+	input := s3.ListObjectsV2Input{
+		Bucket: aws.String(bucket),
+	}
+	if keyPrefix != "" {
+		input.Prefix = aws.String(keyPrefix)
+	}
+
+	keys := make(map[string]struct{})
+
+	pages := s3.NewListObjectsV2Paginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Contents {
+			keys[aws.ToString(v.Key)] = struct{}{}
+		}
+	}
+
+	return keys, nil
+}
+
+// findObjectContentHashes returns the content hash recorded by a previous upload for each of the specified objects.
+// Objects without a recorded hash are omitted.
+func findObjectContentHashes(ctx context.Context, conn *s3.Client, bucket string, keys []string, maxConcurrency int64) (map[string]string, error) {
+	var mu sync.Mutex
+	hashes := make(map[string]string)
+
+	err := forEachConcurrently(keys, maxConcurrency, func(key string) error {
+		output, err := findObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+		if tfresource.NotFound(err) {
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("reading S3 Object (%s) in Bucket (%s): %w", key, bucket, err)
+		}
+
+		if v, ok := output.Metadata[directoryUploadContentHashMetadataKey]; ok {
+			mu.Lock()
+			hashes[key] = v
+			mu.Unlock()
+		}
+
+		return nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return hashes, nil
+}
+
+func deleteDirectoryUploadObjects(ctx context.Context, conn *s3.Client, bucket string, keys []string) (int64, error) {
+	var nObjects int64
+
+	// DeleteObjects accepts at most 1000 keys per call.
+	const (
+		batchSize = 1000
+	)
+	for chunk := range slices.Chunk(keys, batchSize) {
+		toDelete := tfslices.ApplyToAll(chunk, func(key string) awstypes.ObjectIdentifier {
+			return awstypes.ObjectIdentifier{
+				Key: aws.String(key),
+			}
+		})
+
+		n, err := deletePage(ctx, conn, bucket, false, toDelete)
+		nObjects += n
+
+		if err != nil {
+			return nObjects, err
+		}
+	}
+
+	return nObjects, nil
+}
+
+type directoryUploadResourceModel struct {
+	framework.WithRegionModel
+	Bucket         types.String `tfsdk:"bucket"`
+	CacheControl   types.String `tfsdk:"cache_control"`
+	ContentTypes   types.Map    `tfsdk:"content_types"`
+	DeleteRemoved  types.Bool   `tfsdk:"delete_removed"`
+	DeletedCount   types.Int64  `tfsdk:"deleted_count"`
+	Files          types.Map    `tfsdk:"files"`
+	KeyPrefix      types.String `tfsdk:"key_prefix"`
+	MaxConcurrency types.Int64  `tfsdk:"max_concurrency"`
+	MaxFiles       types.Int64  `tfsdk:"max_files"`
+	Source         types.String `tfsdk:"source"`
+	SourceHash     types.String `tfsdk:"source_hash"`
+	UploadedCount  types.Int64  `tfsdk:"uploaded_count"`
+}
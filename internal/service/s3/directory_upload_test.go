@@ -0,0 +1,249 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/google/go-cmp/cmp"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3 "github.com/hashicorp/terraform-provider-aws/internal/service/s3"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestHashDirectoryUploadSource(t *testing.T) {
+	t.Parallel()
+
+	source := t.TempDir()
+	testAccWriteDirectoryUploadFile(t, source, "index.html", "<html></html>")
+	testAccWriteDirectoryUploadFile(t, source, "css/site.css", "body {}")
+
+	// Symbolic links to files are followed and symbolic links to directories are skipped.
+	shared := t.TempDir()
+	testAccWriteDirectoryUploadFile(t, shared, "app.js", "var x;")
+	if err := os.Symlink(filepath.Join(shared, "app.js"), filepath.Join(source, "app.js")); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Symlink(shared, filepath.Join(source, "shared")); err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"site/app.js":       "cd740f5c37a7709795a10171560b317fa183fbee241df93ac94fdd9a7d7aa122",
+		"site/css/site.css": "62368a1a29259b30bac235c0e75dc700c9b3bacf1513ad5708e4fe4a6c0d6560",
+		"site/index.html":   "b633a587c652d02386c4f16f8c6f6aab7352d97f16367c3c40576214372dd628",
+	}
+
+	// A key prefix without a trailing "/" is separated from the relative path by one.
+	for _, keyPrefix := range []string{"site/", "site"} {
+		got, err := tfs3.HashDirectoryUploadSource(source, keyPrefix)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+
+		if diff := cmp.Diff(got, want); diff != "" {
+			t.Errorf("key prefix %q: unexpected diff (+wanted, -got): %s", keyPrefix, diff)
+		}
+	}
+}
+
+func TestAccS3DirectoryUpload_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_upload.test"
+	source := t.TempDir()
+	testAccWriteDirectoryUploadFile(t, source, "index.html", "<html></html>")
+	testAccWriteDirectoryUploadFile(t, source, "css/site.css", "body {}")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryUploadConfig_basic(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryUploadObjects(ctx, resourceName, "site/css/site.css", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_count", "0"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "source_hash"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_count", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryUpload_deleteRemoved(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_s3_directory_upload.test"
+	source := t.TempDir()
+	testAccWriteDirectoryUploadFile(t, source, "index.html", "<html></html>")
+	testAccWriteDirectoryUploadFile(t, source, "css/site.css", "body {}")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDirectoryUploadConfig_deleteRemoved(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryUploadObjects(ctx, resourceName, "site/css/site.css", "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_count", "2"),
+				),
+			},
+			{
+				PreConfig: func() {
+					testAccWriteDirectoryUploadFile(t, source, "index.html", "<html><body></body></html>")
+					if err := os.Remove(filepath.Join(source, "css", "site.css")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDirectoryUploadConfig_deleteRemoved(rName, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckDirectoryUploadObjects(ctx, resourceName, "site/index.html"),
+					resource.TestCheckResourceAttr(resourceName, "deleted_count", "1"),
+					resource.TestCheckResourceAttr(resourceName, "files.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "uploaded_count", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccS3DirectoryUpload_maxFiles(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	source := t.TempDir()
+	testAccWriteDirectoryUploadFile(t, source, "index.html", "<html></html>")
+	testAccWriteDirectoryUploadFile(t, source, "css/site.css", "body {}")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3ServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckBucketDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDirectoryUploadConfig_maxFiles(rName, source, 1),
+				ExpectError: regexache.MustCompile(`contains 2 files, more than max_files \(1\)`),
+			},
+		},
+	})
+}
+
+func testAccWriteDirectoryUploadFile(t *testing.T, root, name, content string) {
+	t.Helper()
+
+	path := filepath.Join(root, filepath.FromSlash(name))
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil { //nolint:gosec // Test fixture
+		t.Fatal(err)
+	}
+}
+
+// testAccCheckDirectoryUploadObjects verifies that exactly the specified keys exist under the upload's key prefix.
+func testAccCheckDirectoryUploadObjects(ctx context.Context, n string, keys ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3Client(ctx)
+
+		bucket := rs.Primary.Attributes[names.AttrBucket]
+		existing, err := tfs3.FindObjectKeysByPrefix(ctx, conn, bucket, rs.Primary.Attributes["key_prefix"])
+
+		if err != nil {
+			return err
+		}
+
+		if len(existing) != len(keys) {
+			return fmt.Errorf("S3 Directory Upload %s has %d objects, expected %d", n, len(existing), len(keys))
+		}
+
+		for _, key := range keys {
+			if _, ok := existing[key]; !ok {
+				return fmt.Errorf("S3 Directory Upload %s object %s not found", n, key)
+			}
+
+			output, err := tfs3.FindObjectByBucketAndKey(ctx, conn, bucket, key, "", "")
+
+			if err != nil {
+				return err
+			}
+
+			if got, want := output.Metadata["terraform-content-sha256"], rs.Primary.Attributes["files."+key]; got != want {
+				return fmt.Errorf("S3 Directory Upload %s object %s content hash is %s, expected %s", n, key, got, want)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccDirectoryUploadConfig_basic(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_upload" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+}
+`, rName, source)
+}
+
+func testAccDirectoryUploadConfig_deleteRemoved(rName, source string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_upload" "test" {
+  bucket          = aws_s3_bucket.test.bucket
+  key_prefix      = "site/"
+  source          = %[2]q
+  delete_removed  = true
+  max_concurrency = 2
+}
+`, rName, source)
+}
+
+func testAccDirectoryUploadConfig_maxFiles(rName, source string, maxFiles int) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_s3_directory_upload" "test" {
+  bucket     = aws_s3_bucket.test.bucket
+  key_prefix = "site/"
+  source     = %[2]q
+  max_files  = %[3]d
+}
+`, rName, source, maxFiles)
+}
@@ -13,6 +13,7 @@ var (
 	ResourceBucketInventory                         = resourceBucketInventory
 	ResourceBucketLifecycleConfiguration            = newBucketLifecycleConfigurationResource
 	ResourceBucketLogging                           = resourceBucketLogging
+	ResourceBucketMetadataConfiguration             = newBucketMetadataConfigurationResource
 	ResourceBucketMetric                            = resourceBucketMetric
 	ResourceBucketNotification                      = resourceBucketNotification
 	ResourceBucketObjectLockConfiguration           = resourceBucketObjectLockConfiguration
@@ -26,6 +27,7 @@ var (
 	ResourceBucketVersioning                        = resourceBucketVersioning
 	ResourceBucketWebsiteConfiguration              = resourceBucketWebsiteConfiguration
 	ResourceDirectoryBucket                         = newDirectoryBucketResource
+	ResourceDirectoryUpload                         = newDirectoryUploadResource
 	ResourceObjectCopy                              = resourceObjectCopy
 
 	BucketUpdateTags                      = bucketUpdateTags
@@ -38,12 +40,14 @@ var (
 	FindBucketACL                         = findBucketACL
 	FindBucketAccelerateConfiguration     = findBucketAccelerateConfiguration
 	FindBucketLifecycleConfiguration      = findBucketLifecycleConfiguration
+	FindBucketMetadataConfiguration       = findBucketMetadataConfiguration
 	FindBucketNotificationConfiguration   = findBucketNotificationConfiguration
 	FindBucketPolicy                      = findBucketPolicy
 	FindBucketRequestPayment              = findBucketRequestPayment
 	FindBucketVersioning                  = findBucketVersioning
 	FindBucketWebsite                     = findBucketWebsite
 	FindCORSRules                         = findCORSRules
+	FindObjectKeysByPrefix                = findObjectKeysByPrefix
 	FindIntelligentTieringConfiguration   = findIntelligentTieringConfiguration
 	FindInventoryConfiguration            = findInventoryConfiguration
 	FindLoggingEnabled                    = findLoggingEnabled
@@ -54,8 +58,10 @@ var (
 	FindPublicAccessBlockConfiguration    = findPublicAccessBlockConfiguration
 	FindReplicationConfiguration          = findReplicationConfiguration
 	FindServerSideEncryptionConfiguration = findServerSideEncryptionConfiguration
+	HashDirectoryUploadSource             = hashDirectoryUploadSource
 	HostedZoneIDForRegion                 = hostedZoneIDForRegion
 	IsDirectoryBucket                     = isDirectoryBucket
+	NotificationTargetARNs                = notificationTargetARNs
 	ObjectListTags                        = objectListTags
 	ObjectUpdateTags                      = objectUpdateTags
 	SDKv1CompatibleCleanKey               = sdkv1CompatibleCleanKey
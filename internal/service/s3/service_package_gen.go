@@ -37,6 +37,18 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			Name:     "Bucket Lifecycle Configuration",
 			Region:   unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newBucketMetadataConfigurationResource,
+			TypeName: "aws_s3_bucket_metadata_configuration",
+			Name:     "Bucket Metadata Configuration",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
+		{
+			Factory:  newBucketNotificationTargetsExclusiveResource,
+			TypeName: "aws_s3_bucket_notification_targets_exclusive",
+			Name:     "Bucket Notification Targets Exclusive",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 		{
 			Factory:  newDirectoryBucketResource,
 			TypeName: "aws_s3_directory_bucket",
@@ -47,6 +59,12 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*inttypes.Ser
 			}),
 			Region: unique.Make(inttypes.ResourceRegionDefault()),
 		},
+		{
+			Factory:  newDirectoryUploadResource,
+			TypeName: "aws_s3_directory_upload",
+			Name:     "Directory Upload",
+			Region:   unique.Make(inttypes.ResourceRegionDefault()),
+		},
 	}
 }
 
@@ -12,6 +12,7 @@ var (
 	ResourceTopicSubscription         = resourceTopicSubscription
 
 	FindPlatformApplicationAttributesByARN         = findPlatformApplicationAttributesByARN
+	FindSubscriptionARNsByTopicARN                 = findSubscriptionARNsByTopicARN
 	FindSubscriptionAttributesByARN                = findSubscriptionAttributesByARN
 	FindTopicAttributesByARN                       = findTopicAttributesByARN
 	FindTopicAttributesWithValidAWSPrincipalsByARN = findTopicAttributesWithValidAWSPrincipalsByARN // nosemgrep:ci.aws-in-var-name
@@ -0,0 +1,225 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sns
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/sns/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_sns_topic_subscriptions_exclusive", name="Topic Subscriptions Exclusive")
+func newTopicSubscriptionsExclusiveResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &topicSubscriptionsExclusiveResource{}, nil
+}
+
+const (
+	ResNameTopicSubscriptionsExclusive = "Topic Subscriptions Exclusive"
+
+	// Subscriptions pending confirmation have no ARN and cannot be unsubscribed.
+	subscriptionARNPendingConfirmation = "PendingConfirmation"
+)
+
+type topicSubscriptionsExclusiveResource struct {
+	framework.ResourceWithModel[topicSubscriptionsExclusiveResourceModel]
+	framework.WithNoOpDelete
+}
+
+func (r *topicSubscriptionsExclusiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"subscription_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.NoNullValues(),
+				},
+			},
+			"topic_arn": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *topicSubscriptionsExclusiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan topicSubscriptionsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var subscriptionARNs []string
+	resp.Diagnostics.Append(plan.SubscriptionARNs.ElementsAs(ctx, &subscriptionARNs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncSubscriptions(ctx, plan.TopicARN.ValueString(), subscriptionARNs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SNS, create.ErrActionCreating, ResNameTopicSubscriptionsExclusive, plan.TopicARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *topicSubscriptionsExclusiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().SNSClient(ctx)
+
+	var state topicSubscriptionsExclusiveResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findSubscriptionARNsByTopicARN(ctx, conn, state.TopicARN.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.SNS, create.ErrActionReading, ResNameTopicSubscriptionsExclusive, state.TopicARN.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	state.SubscriptionARNs = flex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, out)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *topicSubscriptionsExclusiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state topicSubscriptionsExclusiveResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SubscriptionARNs.Equal(state.SubscriptionARNs) {
+		var subscriptionARNs []string
+		resp.Diagnostics.Append(plan.SubscriptionARNs.ElementsAs(ctx, &subscriptionARNs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncSubscriptions(ctx, plan.TopicARN.ValueString(), subscriptionARNs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.SNS, create.ErrActionUpdating, ResNameTopicSubscriptionsExclusive, plan.TopicARN.String(), err),
+				err.Error(),
+			)
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// syncSubscriptions handles keeping the configured topic subscriptions in
+// sync with the remote resource.
+//
+// Confirmed subscriptions to the topic but not configured on this resource
+// will be unsubscribed. Configured subscriptions which do not exist cannot be
+// created by this resource and will return an error.
+func (r *topicSubscriptionsExclusiveResource) syncSubscriptions(ctx context.Context, topicARN string, want []string) error {
+	conn := r.Meta().SNSClient(ctx)
+
+	have, err := findSubscriptionARNsByTopicARN(ctx, conn, topicARN)
+	if err != nil {
+		return err
+	}
+
+	missing, remove, _ := intflex.DiffSlices(have, want, func(s1, s2 string) bool { return s1 == s2 })
+
+	if len(missing) > 0 {
+		return fmt.Errorf("subscriptions not found: %v", missing)
+	}
+
+	for _, arn := range remove {
+		input := sns.UnsubscribeInput{
+			SubscriptionArn: aws.String(arn),
+		}
+
+		_, err := conn.Unsubscribe(ctx, &input)
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *topicSubscriptionsExclusiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("topic_arn"), req, resp)
+}
+
+func findSubscriptionARNsByTopicARN(ctx context.Context, conn *sns.Client, topicARN string) ([]string, error) {
+	input := sns.ListSubscriptionsByTopicInput{
+		TopicArn: aws.String(topicARN),
+	}
+
+	var subscriptionARNs []string
+	pages := sns.NewListSubscriptionsByTopicPaginator(conn, &input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.Subscriptions {
+			if arn := aws.ToString(v.SubscriptionArn); arn != "" && arn != subscriptionARNPendingConfirmation {
+				subscriptionARNs = append(subscriptionARNs, arn)
+			}
+		}
+	}
+
+	return subscriptionARNs, nil
+}
+
+type topicSubscriptionsExclusiveResourceModel struct {
+	framework.WithRegionModel
+	SubscriptionARNs fwtypes.SetOfString `tfsdk:"subscription_arns"`
+	TopicARN         types.String        `tfsdk:"topic_arn"`
+}
@@ -0,0 +1,350 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/taxsettings"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_taxsettings_tax_registration_batch", name="Tax Registration Batch")
+func newTaxRegistrationBatchResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &taxRegistrationBatchResource{}
+
+	return r, nil
+}
+
+type taxRegistrationBatchResource struct {
+	framework.ResourceWithModel[taxRegistrationBatchResourceModel]
+}
+
+func (r *taxRegistrationBatchResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	attributes := taxRegistrationEntryAttributes()
+	attributes["account_ids"] = schema.SetAttribute{
+		CustomType:  fwtypes.SetOfStringType,
+		ElementType: types.StringType,
+		Required:    true,
+		Validators: []validator.Set{
+			setvalidator.SizeAtLeast(1),
+			setvalidator.ValueStringsAre(fwvalidators.AWSAccountID()),
+		},
+	}
+	attributes[names.AttrStatus] = schema.StringAttribute{
+		CustomType: fwtypes.StringEnumType[awstypes.TaxRegistrationStatus](),
+		Computed:   true,
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: attributes,
+		Blocks: map[string]schema.Block{
+			"legal_address": legalAddressBlock(ctx),
+		},
+	}
+}
+
+func (r *taxRegistrationBatchResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data taxRegistrationBatchResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	var entry awstypes.TaxRegistrationEntry
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &entry)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs)
+	if err := batchPutTaxRegistration(ctx, conn, accountIDs, &entry); err != nil {
+		response.Diagnostics.AddError("creating Tax Settings Tax Registration Batch", err.Error())
+
+		return
+	}
+
+	if err := waitTaxRegistrationsCreated(ctx, conn, accountIDs); err != nil {
+		response.Diagnostics.AddError("waiting for Tax Settings Tax Registration Batch create", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.refresh(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *taxRegistrationBatchResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data taxRegistrationBatchResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	response.Diagnostics.Append(r.refresh(ctx, conn, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if len(data.AccountIDs.Elements()) == 0 {
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *taxRegistrationBatchResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new taxRegistrationBatchResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("AccountIDs"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	oldAccountIDs, newAccountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, old.AccountIDs), fwflex.ExpandFrameworkStringValueSet(ctx, new.AccountIDs)
+	add, del := newAccountIDs.Difference(oldAccountIDs), oldAccountIDs.Difference(newAccountIDs)
+
+	// A changed registration entry must be applied to every account.
+	if diff.HasChanges() {
+		add = newAccountIDs
+	}
+
+	if len(add) > 0 {
+		var entry awstypes.TaxRegistrationEntry
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &entry)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if err := batchPutTaxRegistration(ctx, conn, add, &entry); err != nil {
+			response.Diagnostics.AddError("updating Tax Settings Tax Registration Batch", err.Error())
+
+			return
+		}
+
+		if err := waitTaxRegistrationsCreated(ctx, conn, add); err != nil {
+			response.Diagnostics.AddError("waiting for Tax Settings Tax Registration Batch update", err.Error())
+
+			return
+		}
+	}
+
+	if len(del) > 0 {
+		if err := batchDeleteTaxRegistration(ctx, conn, del); err != nil {
+			response.Diagnostics.AddError("updating Tax Settings Tax Registration Batch", err.Error())
+
+			return
+		}
+	}
+
+	response.Diagnostics.Append(r.refresh(ctx, conn, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *taxRegistrationBatchResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data taxRegistrationBatchResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TaxSettingsClient(ctx)
+
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs)
+	tflog.Debug(ctx, "deleting Tax Settings Tax Registration Batch", map[string]any{
+		"account_ids": accountIDs,
+	})
+	if err := batchDeleteTaxRegistration(ctx, conn, accountIDs); err != nil {
+		response.Diagnostics.AddError("deleting Tax Settings Tax Registration Batch", err.Error())
+
+		return
+	}
+}
+
+func (r *taxRegistrationBatchResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	accountIDs := strings.Split(request.ID, ",")
+	if slices.Contains(accountIDs, "") {
+		response.Diagnostics.AddError("Resource Import Invalid ID", fmt.Sprintf("Wrong format for import ID (%s), use: 'account-id[,account-id]...'", request.ID))
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.SetAttribute(ctx, path.Root("account_ids"), fwflex.FlattenFrameworkStringValueSetOfString(ctx, accountIDs))...)
+}
+
+// refresh drops accounts that no longer have a tax registration and sets the
+// registration entry from the first remaining account.
+func (r *taxRegistrationBatchResource) refresh(ctx context.Context, conn *taxsettings.Client, data *taxRegistrationBatchResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	accountIDs := fwflex.ExpandFrameworkStringValueSet(ctx, data.AccountIDs)
+	slices.Sort(accountIDs)
+
+	var (
+		found        []string
+		registration *awstypes.TaxRegistration
+	)
+	for _, accountID := range accountIDs {
+		output, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+		if tfresource.NotFound(err) {
+			tflog.Warn(ctx, "Tax Settings Tax Registration not found", map[string]any{
+				names.AttrAccountID: accountID,
+			})
+
+			continue
+		}
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("reading Tax Settings Tax Registration (%s)", accountID), err.Error())
+
+			return diags
+		}
+
+		found = append(found, accountID)
+		if registration == nil {
+			registration = output
+		}
+	}
+
+	data.AccountIDs = fwflex.FlattenFrameworkStringValueSetOfString(ctx, found)
+
+	if registration != nil {
+		diags.Append(fwflex.Flatten(ctx, registration, data)...)
+	}
+
+	return diags
+}
+
+func batchPutTaxRegistration(ctx context.Context, conn *taxsettings.Client, accountIDs []string, entry *awstypes.TaxRegistrationEntry) error {
+	input := taxsettings.BatchPutTaxRegistrationInput{
+		AccountIds:           accountIDs,
+		TaxRegistrationEntry: entry,
+	}
+	output, err := conn.BatchPutTaxRegistration(ctx, &input)
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, v := range output.Errors {
+		errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(v.AccountId), aws.ToString(v.Code), aws.ToString(v.Message)))
+	}
+
+	return errors.Join(errs...)
+}
+
+// waitTaxRegistrationsCreated waits until a tax registration can be read back for every account.
+func waitTaxRegistrationsCreated(ctx context.Context, conn *taxsettings.Client, accountIDs []string) error {
+	const (
+		timeout = 5 * time.Minute
+	)
+	pending := slices.Clone(accountIDs)
+	_, err := tfresource.RetryWhenNotFound(ctx, timeout, func(ctx context.Context) (any, error) {
+		var notFound []string
+		for _, accountID := range pending {
+			_, err := findTaxRegistrationByAccountID(ctx, conn, accountID)
+
+			if tfresource.NotFound(err) {
+				notFound = append(notFound, accountID)
+				continue
+			}
+
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		if pending = notFound; len(pending) > 0 {
+			return nil, &retry.NotFoundError{
+				Message: fmt.Sprintf("Tax Settings Tax Registrations not found: %s", strings.Join(pending, ", ")),
+			}
+		}
+
+		return nil, nil
+	})
+
+	return err
+}
+
+func batchDeleteTaxRegistration(ctx context.Context, conn *taxsettings.Client, accountIDs []string) error {
+	input := taxsettings.BatchDeleteTaxRegistrationInput{
+		AccountIds: accountIDs,
+	}
+	output, err := conn.BatchDeleteTaxRegistration(ctx, &input)
+
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, v := range output.Errors {
+		if aws.ToString(v.Code) == "ResourceNotFoundException" {
+			continue
+		}
+
+		errs = append(errs, fmt.Errorf("%s: %s: %s", aws.ToString(v.AccountId), aws.ToString(v.Code), aws.ToString(v.Message)))
+	}
+
+	return errors.Join(errs...)
+}
+
+type taxRegistrationBatchResourceModel struct {
+	framework.WithRegionModel
+	AccountIDs       fwtypes.SetOfString                                `tfsdk:"account_ids"`
+	CertifiedEmailID types.String                                       `tfsdk:"certified_email_id"`
+	LegalAddress     fwtypes.ListNestedObjectValueOf[addressModel]      `tfsdk:"legal_address"`
+	LegalName        types.String                                       `tfsdk:"legal_name"`
+	RegistrationID   types.String                                       `tfsdk:"registration_id"`
+	RegistrationType fwtypes.StringEnum[awstypes.TaxRegistrationType]   `tfsdk:"registration_type"`
+	Sector           fwtypes.StringEnum[awstypes.Sector]                `tfsdk:"sector"`
+	Status           fwtypes.StringEnum[awstypes.TaxRegistrationStatus] `tfsdk:"status"`
+}
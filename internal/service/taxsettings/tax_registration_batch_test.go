@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTaxSettingsTaxRegistrationBatch_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	resourceName := "aws_taxsettings_tax_registration_batch.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckOrganizationManagementAccount(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationBatchDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationBatchConfig_basic(registrationID, countryCode, "Test City"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationBatchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "account_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "account_ids.*", "data.aws_organizations_organization.test", "non_master_accounts.0.id"),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.0.city", "Test City"),
+					resource.TestCheckResourceAttr(resourceName, "registration_id", registrationID),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    testAccTaxRegistrationBatchImportStateIDFunc(resourceName),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: "registration_id",
+			},
+			{
+				Config: testAccTaxRegistrationBatchConfig_basic(registrationID, countryCode, "Other City"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationBatchExists(ctx, resourceName),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.0.city", "Other City"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTaxRegistrationBatchExists(ctx context.Context, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		for _, accountID := range testAccTaxRegistrationBatchAccountIDs(rs) {
+			if _, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, accountID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTaxRegistrationBatchDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_tax_registration_batch" {
+				continue
+			}
+
+			for _, accountID := range testAccTaxRegistrationBatchAccountIDs(rs) {
+				_, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, accountID)
+
+				if tfresource.NotFound(err) {
+					continue
+				}
+
+				if err != nil {
+					return err
+				}
+
+				return fmt.Errorf("Tax Settings Tax Registration %s still exists", accountID)
+			}
+		}
+
+		return nil
+	}
+}
+
+func testAccTaxRegistrationBatchImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return strings.Join(testAccTaxRegistrationBatchAccountIDs(rs), ","), nil
+	}
+}
+
+func testAccTaxRegistrationBatchAccountIDs(rs *terraform.ResourceState) []string {
+	var accountIDs []string
+	for i := range errs.Must(strconv.Atoi(rs.Primary.Attributes["account_ids.#"])) { // nosemgrep: ci.avoid-errs-Must
+		accountIDs = append(accountIDs, rs.Primary.Attributes[fmt.Sprintf("account_ids.%d", i)])
+	}
+
+	return accountIDs
+}
+
+func testAccTaxRegistrationBatchConfig_basic(registrationID, countryCode, city string) string {
+	return fmt.Sprintf(`
+data "aws_organizations_organization" "test" {}
+
+resource "aws_taxsettings_tax_registration_batch" "test" {
+  account_ids       = [data.aws_organizations_organization.test.non_master_accounts[0].id]
+  registration_id   = %[1]q
+  registration_type = "VAT"
+  legal_name        = "Example Corp"
+
+  legal_address {
+    address_line_1 = "1 Example Street"
+    city           = %[3]q
+    country_code   = %[2]q
+    postal_code    = "10001"
+  }
+}
+`, registrationID, countryCode, city)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package taxsettings_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/taxsettings/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftaxsettings "github.com/hashicorp/terraform-provider-aws/internal/service/taxsettings"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Tax registrations are validated against real registration numbers, so the
+// tests require a registration ID and matching country to be supplied.
+const (
+	envVarRegistrationID = "AWS_TAXSETTINGS_REGISTRATION_ID"
+	envVarCountryCode    = "AWS_TAXSETTINGS_COUNTRY_CODE"
+)
+
+func TestAccTaxSettingsTaxRegistration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	resourceName := "aws_taxsettings_tax_registration.test"
+	var v awstypes.TaxRegistration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID, countryCode, "Test City"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+					acctest.CheckResourceAttrAccountID(ctx, resourceName, names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.0.city", "Test City"),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.0.country_code", countryCode),
+					resource.TestCheckResourceAttr(resourceName, "registration_id", registrationID),
+					resource.TestCheckResourceAttr(resourceName, "registration_type", "VAT"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStatus),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, names.AttrAccountID),
+				ImportStateVerify:                    true,
+				ImportStateVerifyIdentifierAttribute: names.AttrAccountID,
+			},
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID, countryCode, "Other City"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "legal_address.0.city", "Other City"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTaxSettingsTaxRegistration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	registrationID := acctest.SkipIfEnvVarNotSet(t, envVarRegistrationID)
+	countryCode := acctest.SkipIfEnvVarNotSet(t, envVarCountryCode)
+	resourceName := "aws_taxsettings_tax_registration.test"
+	var v awstypes.TaxRegistration
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.TaxSettingsServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTaxRegistrationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTaxRegistrationConfig_basic(registrationID, countryCode, "Test City"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTaxRegistrationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tftaxsettings.ResourceTaxRegistration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTaxRegistrationExists(ctx context.Context, n string, v *awstypes.TaxRegistration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		output, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckTaxRegistrationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TaxSettingsClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_taxsettings_tax_registration" {
+				continue
+			}
+
+			_, err := tftaxsettings.FindTaxRegistrationByAccountID(ctx, conn, rs.Primary.Attributes[names.AttrAccountID])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Tax Settings Tax Registration %s still exists", rs.Primary.Attributes[names.AttrAccountID])
+		}
+
+		return nil
+	}
+}
+
+func testAccTaxRegistrationConfig_basic(registrationID, countryCode, city string) string {
+	return fmt.Sprintf(`
+resource "aws_taxsettings_tax_registration" "test" {
+  registration_id   = %[1]q
+  registration_type = "VAT"
+  legal_name        = "Example Corp"
+
+  legal_address {
+    address_line_1 = "1 Example Street"
+    city           = %[3]q
+    country_code   = %[2]q
+    postal_code    = "10001"
+  }
+}
+`, registrationID, countryCode, city)
+}
@@ -0,0 +1,17 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+// Exports for use in tests only.
+var (
+	ResourceLensShare      = newLensShareResource
+	ResourceProfile        = newProfileResource
+	ResourceReviewTemplate = newReviewTemplateResource
+	ResourceWorkload       = newWorkloadResource
+
+	FindLensShareByTwoPartKey = findLensShareByTwoPartKey
+	FindProfileByARN          = findProfileByARN
+	FindReviewTemplateByARN   = findReviewTemplateByARN
+	FindWorkloadByID          = findWorkloadByID
+)
@@ -0,0 +1,255 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	intflex "github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_lens_share", name="Lens Share")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.LensShareSummary")
+func newLensShareResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &lensShareResource{}
+
+	return r, nil
+}
+
+const (
+	lensShareResourceIDPartCount = 2
+)
+
+type lensShareResource struct {
+	framework.ResourceWithModel[lensShareResourceModel]
+	framework.WithImportByID
+	framework.WithNoUpdate
+}
+
+func (r *lensShareResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"lens_alias": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"share_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"shared_with": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ShareStatus](),
+				Computed:   true,
+			},
+			names.AttrStatusMessage: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+func (r *lensShareResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	lensAlias := data.LensAlias.ValueString()
+	var input wellarchitected.CreateLensShareInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateLensShare(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Lens (%s) Share", lensAlias), err.Error())
+
+		return
+	}
+
+	shareID := aws.ToString(output.ShareId)
+	id, _ := intflex.FlattenResourceId([]string{lensAlias, shareID}, lensShareResourceIDPartCount, false)
+	share, err := findLensShareByTwoPartKey(ctx, conn, lensAlias, shareID)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens Share (%s)", id), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, share, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.ID = fwflex.StringValueToFramework(ctx, id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *lensShareResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := data.ID.ValueString()
+	parts, err := intflex.ExpandResourceId(id, lensShareResourceIDPartCount, false)
+
+	if err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	lensAlias, shareID := parts[0], parts[1]
+	share, err := findLensShareByTwoPartKey(ctx, conn, lensAlias, shareID)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Lens Share (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, share, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	data.LensAlias = fwflex.StringValueToFramework(ctx, lensAlias)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *lensShareResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data lensShareResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := data.ID.ValueString()
+	tflog.Debug(ctx, "deleting Well-Architected Lens Share", map[string]any{
+		names.AttrID: id,
+	})
+	input := wellarchitected.DeleteLensShareInput{
+		LensAlias: fwflex.StringFromFramework(ctx, data.LensAlias),
+		ShareId:   fwflex.StringFromFramework(ctx, data.ShareID),
+	}
+	_, err := conn.DeleteLensShare(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Lens Share (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func findLensShareByTwoPartKey(ctx context.Context, conn *wellarchitected.Client, lensAlias, shareID string) (*awstypes.LensShareSummary, error) {
+	input := wellarchitected.ListLensSharesInput{
+		LensAlias: aws.String(lensAlias),
+	}
+
+	return findLensShare(ctx, conn, &input, func(v *awstypes.LensShareSummary) bool {
+		return aws.ToString(v.ShareId) == shareID
+	})
+}
+
+func findLensShare(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) (*awstypes.LensShareSummary, error) {
+	output, err := findLensShares(ctx, conn, input, filter)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findLensShares(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensSharesInput, filter tfslices.Predicate[*awstypes.LensShareSummary]) ([]awstypes.LensShareSummary, error) {
+	var output []awstypes.LensShareSummary
+
+	pages := wellarchitected.NewListLensSharesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.LensShareSummaries {
+			if filter(&v) {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+type lensShareResourceModel struct {
+	framework.WithRegionModel
+	ID            types.String                             `tfsdk:"id"`
+	LensAlias     types.String                             `tfsdk:"lens_alias"`
+	ShareID       types.String                             `tfsdk:"share_id"`
+	SharedWith    types.String                             `tfsdk:"shared_with"`
+	Status        fwtypes.StringEnum[awstypes.ShareStatus] `tfsdk:"status"`
+	StatusMessage types.String                             `tfsdk:"status_message"`
+}
@@ -0,0 +1,143 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfwellarchitected "github.com/hashicorp/terraform-provider-aws/internal/service/wellarchitected"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// Only custom lenses can be shared. The lens must be created outside of Terraform.
+const envVarLensAlias = "WELLARCHITECTED_LENS_ALIAS"
+
+func TestAccWellArchitectedLensShare_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	lensAlias := acctest.SkipIfEnvVarNotSet(t, envVarLensAlias)
+	resourceName := "aws_wellarchitected_lens_share.test"
+	var v awstypes.LensShareSummary
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLensShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensShareConfig_basic(lensAlias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensShareExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "lens_alias", lensAlias),
+					resource.TestCheckResourceAttrSet(resourceName, "share_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "shared_with", "data.aws_caller_identity.alternate", names.AttrAccountID),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, string(awstypes.ShareStatusPending)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccWellArchitectedLensShare_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	lensAlias := acctest.SkipIfEnvVarNotSet(t, envVarLensAlias)
+	resourceName := "aws_wellarchitected_lens_share.test"
+	var v awstypes.LensShareSummary
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			acctest.PreCheckAlternateAccount(t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5FactoriesAlternate(ctx, t),
+		CheckDestroy:             testAccCheckLensShareDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLensShareConfig_basic(lensAlias),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckLensShareExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfwellarchitected.ResourceLensShare, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckLensShareExists(ctx context.Context, n string, v *awstypes.LensShareSummary) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		output, err := tfwellarchitected.FindLensShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["share_id"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccCheckLensShareDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).WellArchitectedClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_wellarchitected_lens_share" {
+				continue
+			}
+
+			_, err := tfwellarchitected.FindLensShareByTwoPartKey(ctx, conn, rs.Primary.Attributes["lens_alias"], rs.Primary.Attributes["share_id"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Well-Architected Lens Share %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccLensShareConfig_basic(lensAlias string) string {
+	return acctest.ConfigCompose(acctest.ConfigAlternateAccountProvider(), fmt.Sprintf(`
+data "aws_caller_identity" "alternate" {
+  provider = "awsalternate"
+}
+
+resource "aws_wellarchitected_lens_share" "test" {
+  lens_alias  = %[1]q
+  shared_with = data.aws_caller_identity.alternate.account_id
+}
+`, lensAlias))
+}
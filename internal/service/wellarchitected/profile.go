@@ -0,0 +1,322 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_profile", name="Profile")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Profile")
+func newProfileResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &profileResource{}
+
+	return r, nil
+}
+
+type profileResource struct {
+	framework.ResourceWithModel[profileResourceModel]
+}
+
+func (r *profileResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"profile_description": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"profile_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"profile_version": schema.StringAttribute{
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"profile_question": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[profileQuestionModel](ctx),
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"question_id": schema.StringAttribute{
+							Required: true,
+						},
+						"selected_choice_ids": schema.SetAttribute{
+							CustomType:  fwtypes.SetOfStringType,
+							ElementType: types.StringType,
+							Required:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var profileFlexOpt = fwflex.WithFieldNamePrefix("Profile")
+
+func (r *profileResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.ProfileName.ValueString()
+	var input wellarchitected.CreateProfileInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, profileFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateProfile(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Profile (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.ProfileArn)
+	profile, err := findProfileByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenProfile(ctx, profile, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *profileResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	profile, err := findProfileByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(flattenProfile(ctx, profile, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, profile.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *profileResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new profileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.ARN.ValueString()
+
+	if !new.ProfileDescription.Equal(old.ProfileDescription) || !new.ProfileQuestions.Equal(old.ProfileQuestions) {
+		var input wellarchitected.UpdateProfileInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, profileFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Questions that are no longer configured must be explicitly cleared.
+		questionIDs := tfslices.ApplyToAll(input.ProfileQuestions, func(v awstypes.ProfileQuestionUpdate) string {
+			return aws.ToString(v.QuestionId)
+		})
+		oldQuestions, d := old.ProfileQuestions.ToSlice(ctx)
+		response.Diagnostics.Append(d...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+		for _, v := range oldQuestions {
+			if questionID := v.QuestionID.ValueString(); !slices.Contains(questionIDs, questionID) {
+				input.ProfileQuestions = append(input.ProfileQuestions, awstypes.ProfileQuestionUpdate{
+					QuestionId:        aws.String(questionID),
+					SelectedChoiceIds: []string{},
+				})
+			}
+		}
+
+		_, err := conn.UpdateProfile(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Profile (%s)", arn), err.Error())
+
+			return
+		}
+
+		profile, err := findProfileByARN(ctx, conn, arn)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Profile (%s)", arn), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(flattenProfile(ctx, profile, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		new.ProfileVersion = old.ProfileVersion
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *profileResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data profileResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	tflog.Debug(ctx, "deleting Well-Architected Profile", map[string]any{
+		names.AttrARN: arn,
+	})
+	input := wellarchitected.DeleteProfileInput{
+		ProfileArn: aws.String(arn),
+	}
+	_, err := conn.DeleteProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Profile (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *profileResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func flattenProfile(ctx context.Context, profile *awstypes.Profile, data *profileResourceModel) diag.Diagnostics {
+	// Only questions with selected choices are managed by this resource.
+	profile.ProfileQuestions = tfslices.Filter(profile.ProfileQuestions, func(v awstypes.ProfileQuestion) bool {
+		return len(v.SelectedChoiceIds) > 0
+	})
+
+	return fwflex.Flatten(ctx, profile, data, profileFlexOpt)
+}
+
+func findProfileByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.Profile, error) {
+	input := wellarchitected.GetProfileInput{
+		ProfileArn: aws.String(arn),
+	}
+	output, err := conn.GetProfile(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Profile == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Profile, nil
+}
+
+type profileResourceModel struct {
+	framework.WithRegionModel
+	ARN                types.String                                         `tfsdk:"arn"`
+	ProfileDescription types.String                                         `tfsdk:"profile_description"`
+	ProfileName        types.String                                         `tfsdk:"profile_name"`
+	ProfileQuestions   fwtypes.SetNestedObjectValueOf[profileQuestionModel] `tfsdk:"profile_question"`
+	ProfileVersion     types.String                                         `tfsdk:"profile_version"`
+	Tags               tftags.Map                                           `tfsdk:"tags"`
+	TagsAll            tftags.Map                                           `tfsdk:"tags_all"`
+}
+
+type profileQuestionModel struct {
+	QuestionID        types.String        `tfsdk:"question_id"`
+	SelectedChoiceIDs fwtypes.SetOfString `tfsdk:"selected_choice_ids"`
+}
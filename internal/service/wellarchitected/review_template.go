@@ -0,0 +1,291 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_review_template", name="Review Template")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.ReviewTemplate")
+func newReviewTemplateResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &reviewTemplateResource{}
+
+	return r, nil
+}
+
+type reviewTemplateResource struct {
+	framework.ResourceWithModel[reviewTemplateResourceModel]
+}
+
+func (r *reviewTemplateResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"template_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+			"update_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ReviewTemplateUpdateStatus](),
+				Computed:   true,
+			},
+		},
+	}
+}
+
+var reviewTemplateFlexOpt = fwflex.WithFieldNamePrefix("Template")
+
+func (r *reviewTemplateResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.TemplateName.ValueString()
+	var input wellarchitected.CreateReviewTemplateInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, reviewTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateReviewTemplate(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Review Template (%s)", name), err.Error())
+
+		return
+	}
+
+	arn := aws.ToString(output.TemplateArn)
+	template, err := findReviewTemplateByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Review Template (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, template, &data, reviewTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *reviewTemplateResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	template, err := findReviewTemplateByARN(ctx, conn, arn)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Review Template (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, template, &data, reviewTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, template.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *reviewTemplateResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new reviewTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := new.ARN.ValueString()
+
+	if !new.Description.Equal(old.Description) ||
+		!new.Lenses.Equal(old.Lenses) ||
+		!new.Notes.Equal(old.Notes) ||
+		!new.TemplateName.Equal(old.TemplateName) {
+		input := wellarchitected.UpdateReviewTemplateInput{
+			Description:  fwflex.StringFromFramework(ctx, new.Description),
+			Notes:        fwflex.StringFromFramework(ctx, new.Notes),
+			TemplateArn:  aws.String(arn),
+			TemplateName: fwflex.StringFromFramework(ctx, new.TemplateName),
+		}
+
+		if new.Notes.IsNull() {
+			input.Notes = aws.String("")
+		}
+
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses)
+		input.LensesToAssociate, input.LensesToDisassociate = n.Difference(o), o.Difference(n)
+
+		_, err := conn.UpdateReviewTemplate(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Review Template (%s)", arn), err.Error())
+
+			return
+		}
+	}
+
+	template, err := findReviewTemplateByARN(ctx, conn, arn)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Review Template (%s)", arn), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, template, &new, reviewTemplateFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *reviewTemplateResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data reviewTemplateResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	arn := data.ARN.ValueString()
+	tflog.Debug(ctx, "deleting Well-Architected Review Template", map[string]any{
+		names.AttrARN: arn,
+	})
+	input := wellarchitected.DeleteReviewTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	_, err := conn.DeleteReviewTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Review Template (%s)", arn), err.Error())
+
+		return
+	}
+}
+
+func (r *reviewTemplateResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrARN), request, response)
+}
+
+func findReviewTemplateByARN(ctx context.Context, conn *wellarchitected.Client, arn string) (*awstypes.ReviewTemplate, error) {
+	input := wellarchitected.GetReviewTemplateInput{
+		TemplateArn: aws.String(arn),
+	}
+	output, err := conn.GetReviewTemplate(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ReviewTemplate == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ReviewTemplate, nil
+}
+
+type reviewTemplateResourceModel struct {
+	framework.WithRegionModel
+	ARN          types.String                                            `tfsdk:"arn"`
+	Description  types.String                                            `tfsdk:"description"`
+	Lenses       fwtypes.SetOfString                                     `tfsdk:"lenses"`
+	Notes        types.String                                            `tfsdk:"notes" autoflex:",legacy"`
+	Tags         tftags.Map                                              `tfsdk:"tags"`
+	TagsAll      tftags.Map                                              `tfsdk:"tags_all"`
+	TemplateName types.String                                            `tfsdk:"template_name"`
+	UpdateStatus fwtypes.StringEnum[awstypes.ReviewTemplateUpdateStatus] `tfsdk:"update_status"`
+}
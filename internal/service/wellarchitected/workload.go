@@ -0,0 +1,451 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource("aws_wellarchitected_workload", name="Workload")
+// @Tags(identifierAttribute="arn")
+// @Testing(existsType="github.com/aws/aws-sdk-go-v2/service/wellarchitected/types;awstypes;awstypes.Workload")
+func newWorkloadResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &workloadResource{}
+
+	return r, nil
+}
+
+type workloadResource struct {
+	framework.ResourceWithModel[workloadResourceModel]
+	framework.WithImportByID
+}
+
+func (r *workloadResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account_ids": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"applications": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(1),
+				},
+			},
+			"architectural_design": schema.StringAttribute{
+				Optional: true,
+			},
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			"aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 250),
+				},
+			},
+			names.AttrEnvironment: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadEnvironment](),
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"improvement_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.WorkloadImprovementStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"industry": schema.StringAttribute{
+				Optional: true,
+			},
+			"industry_type": schema.StringAttribute{
+				Optional: true,
+			},
+			"lenses": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"non_aws_regions": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(2084),
+				},
+			},
+			"pillar_priorities": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"profile_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"review_owner": schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"review_template_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfARNType,
+				ElementType: fwtypes.ARNType,
+				Optional:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"workload_name": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 100),
+				},
+			},
+		},
+	}
+}
+
+var workloadFlexOpt = fwflex.WithFieldNamePrefix("Workload")
+
+func (r *workloadResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	name := data.WorkloadName.ValueString()
+	var input wellarchitected.CreateWorkloadInput
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, &input, workloadFlexOpt)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateWorkload(ctx, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Well-Architected Workload (%s)", name), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.WorkloadId)
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, workload, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *workloadResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := data.ID.ValueString()
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, workload, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	setTagsOut(ctx, workload.Tags)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *workloadResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := new.ID.ValueString()
+
+	diff, d := fwflex.Diff(ctx, new, old, fwflex.WithIgnoredField("Lenses"), fwflex.WithIgnoredField("ProfileARNs"))
+	response.Diagnostics.Append(d...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if diff.HasChanges() {
+		var input wellarchitected.UpdateWorkloadInput
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, &input, workloadFlexOpt)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		if !new.ReviewOwner.Equal(old.ReviewOwner) {
+			input.IsReviewOwnerUpdateAcknowledged = aws.Bool(true)
+		}
+
+		_, err := conn.UpdateWorkload(ctx, &input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Well-Architected Workload (%s)", id), err.Error())
+
+			return
+		}
+	}
+
+	if !new.Lenses.Equal(old.Lenses) {
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, old.Lenses), fwflex.ExpandFrameworkStringValueSet(ctx, new.Lenses)
+
+		if add := n.Difference(o); len(add) > 0 {
+			input := wellarchitected.AssociateLensesInput{
+				LensAliases: add,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.AssociateLenses(ctx, &input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) lenses", id), err.Error())
+
+				return
+			}
+		}
+
+		if del := o.Difference(n); len(del) > 0 {
+			input := wellarchitected.DisassociateLensesInput{
+				LensAliases: del,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.DisassociateLenses(ctx, &input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) lenses", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	if !new.ProfileARNs.IsUnknown() && !new.ProfileARNs.Equal(old.ProfileARNs) {
+		o, n := fwflex.ExpandFrameworkStringValueSet(ctx, old.ProfileARNs), fwflex.ExpandFrameworkStringValueSet(ctx, new.ProfileARNs)
+
+		if del := o.Difference(n); len(del) > 0 {
+			input := wellarchitected.DisassociateProfilesInput{
+				ProfileArns: del,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.DisassociateProfiles(ctx, &input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("disassociating Well-Architected Workload (%s) profiles", id), err.Error())
+
+				return
+			}
+		}
+
+		if add := n.Difference(o); len(add) > 0 {
+			input := wellarchitected.AssociateProfilesInput{
+				ProfileArns: add,
+				WorkloadId:  aws.String(id),
+			}
+
+			if _, err := conn.AssociateProfiles(ctx, &input); err != nil {
+				response.Diagnostics.AddError(fmt.Sprintf("associating Well-Architected Workload (%s) profiles", id), err.Error())
+
+				return
+			}
+		}
+	}
+
+	workload, err := findWorkloadByID(ctx, conn, id)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s)", id), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, workload, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *workloadResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data workloadResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().WellArchitectedClient(ctx)
+
+	id := data.ID.ValueString()
+	tflog.Debug(ctx, "deleting Well-Architected Workload", map[string]any{
+		names.AttrID: id,
+	})
+	input := wellarchitected.DeleteWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+	_, err := conn.DeleteWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Well-Architected Workload (%s)", id), err.Error())
+
+		return
+	}
+}
+
+func (r *workloadResource) flatten(ctx context.Context, workload *awstypes.Workload, data *workloadResourceModel) (diags diag.Diagnostics) {
+	diags.Append(fwflex.Flatten(ctx, workload, data, workloadFlexOpt)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	data.ProfileARNs = fwflex.FlattenFrameworkStringValueSetOfStringLegacy(ctx, tfslices.ApplyToAll(workload.Profiles, func(v awstypes.WorkloadProfile) string {
+		return aws.ToString(v.ProfileArn)
+	}))
+
+	return diags
+}
+
+func findWorkloadByID(ctx context.Context, conn *wellarchitected.Client, id string) (*awstypes.Workload, error) {
+	input := wellarchitected.GetWorkloadInput{
+		WorkloadId: aws.String(id),
+	}
+	output, err := conn.GetWorkload(ctx, &input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.Workload == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.Workload, nil
+}
+
+type workloadResourceModel struct {
+	framework.WithRegionModel
+	AccountIDs          fwtypes.SetOfString                                    `tfsdk:"account_ids"`
+	Applications        fwtypes.SetOfString                                    `tfsdk:"applications"`
+	ArchitecturalDesign types.String                                           `tfsdk:"architectural_design" autoflex:",legacy"`
+	ARN                 types.String                                           `tfsdk:"arn"`
+	AWSRegions          fwtypes.SetOfString                                    `tfsdk:"aws_regions"`
+	Description         types.String                                           `tfsdk:"description"`
+	Environment         fwtypes.StringEnum[awstypes.WorkloadEnvironment]       `tfsdk:"environment"`
+	ID                  types.String                                           `tfsdk:"id"`
+	ImprovementStatus   fwtypes.StringEnum[awstypes.WorkloadImprovementStatus] `tfsdk:"improvement_status"`
+	Industry            types.String                                           `tfsdk:"industry" autoflex:",legacy"`
+	IndustryType        types.String                                           `tfsdk:"industry_type" autoflex:",legacy"`
+	Lenses              fwtypes.SetOfString                                    `tfsdk:"lenses"`
+	NonAWSRegions       fwtypes.SetOfString                                    `tfsdk:"non_aws_regions"`
+	Notes               types.String                                           `tfsdk:"notes" autoflex:",legacy"`
+	PillarPriorities    fwtypes.ListOfString                                   `tfsdk:"pillar_priorities"`
+	ProfileARNs         fwtypes.SetOfString                                    `tfsdk:"profile_arns"`
+	ReviewOwner         types.String                                           `tfsdk:"review_owner"`
+	ReviewTemplateARNs  fwtypes.SetOfARN                                       `tfsdk:"review_template_arns" autoflex:",omitempty"`
+	Tags                tftags.Map                                             `tfsdk:"tags"`
+	TagsAll             tftags.Map                                             `tfsdk:"tags_all"`
+	WorkloadName        types.String                                           `tfsdk:"workload_name"`
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/wellarchitected"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/wellarchitected/types"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+// @FrameworkDataSource("aws_wellarchitected_workload_lens_reviews", name="Workload Lens Reviews")
+func newWorkloadLensReviewsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	return &workloadLensReviewsDataSource{}, nil
+}
+
+type workloadLensReviewsDataSource struct {
+	framework.DataSourceWithModel[workloadLensReviewsDataSourceModel]
+}
+
+func (d *workloadLensReviewsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"lens_reviews": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[lensReviewSummaryModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[lensReviewSummaryModel](ctx),
+				Computed:    true,
+			},
+			"milestone_number": schema.Int64Attribute{
+				Optional: true,
+			},
+			"workload_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *workloadLensReviewsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data workloadLensReviewsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().WellArchitectedClient(ctx)
+
+	workloadID := data.WorkloadID.ValueString()
+	input := wellarchitected.ListLensReviewsInput{
+		MilestoneNumber: fwflex.Int32FromFrameworkInt64(ctx, data.MilestoneNumber),
+		WorkloadId:      aws.String(workloadID),
+	}
+	output, err := findLensReviewSummaries(ctx, conn, &input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Well-Architected Workload (%s) lens reviews", workloadID), err.Error())
+
+		return
+	}
+
+	lensReviews := make([]lensReviewSummaryModel, 0, len(output))
+	for _, v := range output {
+		lensReview := lensReviewSummaryModel{
+			LensAlias:   fwflex.StringToFramework(ctx, v.LensAlias),
+			LensARN:     fwflex.StringToFramework(ctx, v.LensArn),
+			LensName:    fwflex.StringToFramework(ctx, v.LensName),
+			LensStatus:  fwtypes.StringEnumValue(v.LensStatus),
+			LensVersion: fwflex.StringToFramework(ctx, v.LensVersion),
+		}
+
+		var diags diag.Diagnostics
+		lensReview.PrioritizedRiskCounts, diags = flattenRiskCounts(ctx, v.PrioritizedRiskCounts)
+		response.Diagnostics.Append(diags...)
+		lensReview.RiskCounts, diags = flattenRiskCounts(ctx, v.RiskCounts)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		lensReviews = append(lensReviews, lensReview)
+	}
+
+	data.LensReviews = fwtypes.NewListNestedObjectValueOfValueSliceMust(ctx, lensReviews)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func flattenRiskCounts(ctx context.Context, apiObject map[string]int32) (fwtypes.MapValueOf[types.Int64], diag.Diagnostics) {
+	elements := make(map[string]attr.Value, len(apiObject))
+	for k, v := range apiObject {
+		elements[k] = types.Int64Value(int64(v))
+	}
+
+	return fwtypes.NewMapValueOf[types.Int64](ctx, elements)
+}
+
+func findLensReviewSummaries(ctx context.Context, conn *wellarchitected.Client, input *wellarchitected.ListLensReviewsInput) ([]awstypes.LensReviewSummary, error) {
+	var output []awstypes.LensReviewSummary
+
+	pages := wellarchitected.NewListLensReviewsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.LensReviewSummaries...)
+	}
+
+	return output, nil
+}
+
+type workloadLensReviewsDataSourceModel struct {
+	framework.WithRegionModel
+	LensReviews     fwtypes.ListNestedObjectValueOf[lensReviewSummaryModel] `tfsdk:"lens_reviews"`
+	MilestoneNumber types.Int64                                             `tfsdk:"milestone_number"`
+	WorkloadID      types.String                                            `tfsdk:"workload_id"`
+}
+
+type lensReviewSummaryModel struct {
+	LensAlias             types.String                            `tfsdk:"lens_alias"`
+	LensARN               types.String                            `tfsdk:"lens_arn"`
+	LensName              types.String                            `tfsdk:"lens_name"`
+	LensStatus            fwtypes.StringEnum[awstypes.LensStatus] `tfsdk:"lens_status"`
+	LensVersion           types.String                            `tfsdk:"lens_version"`
+	PrioritizedRiskCounts fwtypes.MapValueOf[types.Int64]         `tfsdk:"prioritized_risk_counts"`
+	RiskCounts            fwtypes.MapValueOf[types.Int64]         `tfsdk:"risk_counts"`
+}
@@ -0,0 +1,46 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package wellarchitected_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccWellArchitectedWorkloadLensReviewsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_wellarchitected_workload_lens_reviews.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.WellArchitectedServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckWorkloadDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccWorkloadLensReviewsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "lens_reviews.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "lens_reviews.0.lens_alias", "wellarchitected"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lens_reviews.0.lens_arn"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lens_reviews.0.lens_name"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lens_reviews.0.risk_counts.UNANSWERED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccWorkloadLensReviewsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccWorkloadConfig_basic(rName, "PREPRODUCTION"), `
+data "aws_wellarchitected_workload_lens_reviews" "test" {
+  workload_id = aws_wellarchitected_workload.test.id
+}
+`)
+}